@@ -0,0 +1,67 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package defs
+
+import (
+	"mime"
+	"strconv"
+	"strings"
+)
+
+// These are the media types without their version parameter, as they are
+// returned by ParseAcceptMediaType().
+const (
+	MEDIA_TYPE_STIX20_BASE  = "application/vnd.oasis.stix+json"
+	MEDIA_TYPE_TAXII20_BASE = "application/vnd.oasis.taxii+json"
+)
+
+// knownMediaTypes - These are the base media types that ParseAcceptMediaType()
+// will match.
+var knownMediaTypes = map[string]bool{
+	MEDIA_TYPE_STIX:         true,
+	MEDIA_TYPE_TAXII:        true,
+	MEDIA_TYPE_STIX20_BASE:  true,
+	MEDIA_TYPE_TAXII20_BASE: true,
+}
+
+// ParseAcceptMediaType - This function will take in the value of an Accept or
+// Content-Type header and return the base media type and version of the
+// STIX or TAXII media type in it. If the header lists more than one media
+// type, the one with the highest quality value is used, and the first one
+// listed wins a tie. The version is empty if the media type did not have one.
+// The boolean is false if the header did not have a STIX or TAXII media type.
+func ParseAcceptMediaType(header string) (base, version string, ok bool) {
+	best := -1.0
+	for _, part := range strings.Split(header, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil || !knownMediaTypes[mediaType] {
+			continue
+		}
+
+		q := 1.0
+		if s, found := params["q"]; found {
+			if q, err = strconv.ParseFloat(s, 64); err != nil {
+				continue
+			}
+		}
+
+		if q > best {
+			best = q
+			base, version, ok = mediaType, params["version"], true
+		}
+	}
+	return base, version, ok
+}
+
+// BuildContentType - This function will take in a base media type and a
+// version and return the value for a Content-Type header, written the same way
+// as the MEDIA_TYPE constants. If the version is empty the base is returned.
+func BuildContentType(base, version string) string {
+	if version == "" {
+		return base
+	}
+	return base + ";version=" + version
+}
@@ -0,0 +1,54 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package defs
+
+import "testing"
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestParseAcceptMediaType - Make sure the STIX and TAXII media types are found
+in Accept headers and the quality values are honored.
+*/
+func TestParseAcceptMediaType(t *testing.T) {
+	tests := []struct {
+		header  string
+		base    string
+		version string
+		ok      bool
+	}{
+		{MEDIA_TYPE_TAXII21, MEDIA_TYPE_TAXII, "2.1", true},
+		{"application/taxii+json; version=2.1", MEDIA_TYPE_TAXII, "2.1", true},
+		{"application/stix+json", MEDIA_TYPE_STIX, "", true},
+		{MEDIA_TYPE_TAXII20, MEDIA_TYPE_TAXII20_BASE, "2.0", true},
+		{"text/html, application/taxii+json;version=2.1", MEDIA_TYPE_TAXII, "2.1", true},
+		{"application/taxii+json;version=2.0;q=0.5, application/taxii+json;version=2.1", MEDIA_TYPE_TAXII, "2.1", true},
+		{"application/json", "", "", false},
+		{"", "", "", false},
+	}
+
+	for _, test := range tests {
+		base, version, ok := ParseAcceptMediaType(test.header)
+		if base != test.base || version != test.version || ok != test.ok {
+			t.Errorf("Fail %q gave %q %q %t", test.header, base, version, ok)
+		}
+	}
+}
+
+/*
+TestBuildContentType - Make sure the content type is written the same way as
+the media type constants.
+*/
+func TestBuildContentType(t *testing.T) {
+	if got := BuildContentType(MEDIA_TYPE_TAXII, "2.1"); got != MEDIA_TYPE_TAXII21 {
+		t.Errorf("Fail expected %s, got %s", MEDIA_TYPE_TAXII21, got)
+	}
+	if got := BuildContentType(MEDIA_TYPE_STIX, ""); got != MEDIA_TYPE_STIX {
+		t.Errorf("Fail expected %s, got %s", MEDIA_TYPE_STIX, got)
+	}
+}
@@ -4,4 +4,5 @@ go 1.25.3
 
 require (
 	github.com/google/uuid v1.6.0
+	golang.org/x/text v0.31.0
 )
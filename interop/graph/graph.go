@@ -0,0 +1,126 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+/*
+Package graph exports the objects in a STIX bundle as a GraphViz DOT digraph.
+
+Each object in the bundle becomes a node labeled with its type and, when it
+has one, its name. Relationship objects become an edge from the source_ref to
+the target_ref labeled with the relationship_type. Sightings become an edge
+from each where_sighted_ref to the sighting_of_ref. Embedded references like
+object_refs and sample_refs are also drawn as edges labeled with the property
+name.
+*/
+package graph
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/freetaxii/libstix2/objects/bundle"
+	"github.com/freetaxii/libstix2/objects/grouping"
+	"github.com/freetaxii/libstix2/objects/malware"
+	"github.com/freetaxii/libstix2/objects/note"
+	"github.com/freetaxii/libstix2/objects/observeddata"
+	"github.com/freetaxii/libstix2/objects/opinion"
+	"github.com/freetaxii/libstix2/objects/relationship"
+	"github.com/freetaxii/libstix2/objects/report"
+	"github.com/freetaxii/libstix2/objects/sighting"
+)
+
+// namer - This interface is satisfied by every object that embeds the
+// NameProperty.
+type namer interface {
+	GetName() string
+}
+
+// ----------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------
+
+/*
+ToDOT - This function will take in a bundle and write a GraphViz digraph of the
+objects and their relationships to w. Nodes and edges are written in the order
+the objects are found in the bundle.
+*/
+func ToDOT(b *bundle.Bundle, w io.Writer) error {
+	if b == nil {
+		return errors.New("no bundle was provided to export")
+	}
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "digraph %s {\n", quote(b.ID))
+
+	for _, v := range b.Objects {
+		c := v.GetCommonProperties()
+
+		switch obj := v.(type) {
+		case *relationship.Relationship:
+			writeEdge(bw, obj.SourceRef, obj.TargetRef, obj.RelationshipType)
+			continue
+		case *sighting.Sighting:
+			if len(obj.WhereSightedRefs) == 0 {
+				writeNode(bw, c.ID, c.ObjectType)
+				writeEdge(bw, c.ID, obj.SightingOfRef, "sighting-of")
+			}
+			for _, ref := range obj.WhereSightedRefs {
+				writeEdge(bw, ref, obj.SightingOfRef, "sighted")
+			}
+			continue
+		}
+
+		label := c.ObjectType
+		if n, ok := v.(namer); ok && n.GetName() != "" {
+			label += "\n" + n.GetName()
+		}
+		writeNode(bw, c.ID, label)
+
+		switch obj := v.(type) {
+		case *grouping.Grouping:
+			writeEdges(bw, c.ID, obj.ObjectRefs, "object_refs")
+		case *malware.Malware:
+			writeEdges(bw, c.ID, obj.SampleRefs, "sample_refs")
+		case *note.Note:
+			writeEdges(bw, c.ID, obj.ObjectRefs, "object_refs")
+		case *observeddata.ObservedData:
+			writeEdges(bw, c.ID, obj.ObjectRefs, "object_refs")
+		case *opinion.Opinion:
+			writeEdges(bw, c.ID, obj.ObjectRefs, "object_refs")
+		case *report.Report:
+			writeEdges(bw, c.ID, obj.ObjectRefs, "object_refs")
+		}
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+func writeNode(w io.Writer, id, label string) {
+	fmt.Fprintf(w, "    %s [label=%s];\n", quote(id), quote(label))
+}
+
+func writeEdge(w io.Writer, from, to, label string) {
+	fmt.Fprintf(w, "    %s -> %s [label=%s];\n", quote(from), quote(to), quote(label))
+}
+
+func writeEdges(w io.Writer, from string, refs []string, label string) {
+	for _, ref := range refs {
+		writeEdge(w, from, ref, label)
+	}
+}
+
+// quote - This function returns s as a quoted DOT string. A new line in s is
+// written as the DOT \n escape so it is rendered as a line break.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return `"` + r.Replace(s) + `"`
+}
@@ -0,0 +1,117 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package graph
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/bundle"
+	"github.com/freetaxii/libstix2/objects/identity"
+	"github.com/freetaxii/libstix2/objects/malware"
+	"github.com/freetaxii/libstix2/objects/relationship"
+	"github.com/freetaxii/libstix2/objects/report"
+	"github.com/freetaxii/libstix2/objects/sighting"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestToDOT - Make sure every object becomes a node, and relationships,
+sightings, and embedded references become the expected edges.
+*/
+func TestToDOT(t *testing.T) {
+	m := malware.New()
+	m.SetName("Poison Ivy")
+	i := identity.New()
+	i.SetName("ACME")
+
+	r := relationship.New()
+	r.SetType("targets")
+	r.SetSourceTarget(m.ID, i.ID)
+
+	s := sighting.New()
+	s.SetSightingOfRef(m.ID)
+	s.AddWhereSightedRefs(i.ID)
+
+	rep := report.New()
+	rep.SetName("Weekly")
+	rep.AddObjectRef(m.ID)
+
+	b := bundle.New()
+	b.AddObject(m)
+	b.AddObject(i)
+	b.AddObject(r)
+	b.AddObject(s)
+	b.AddObject(rep)
+
+	var buf bytes.Buffer
+	if err := ToDOT(b, &buf); err != nil {
+		t.Fatalf("Fail unexpected error %v", err)
+	}
+
+	want := []string{
+		fmt.Sprintf("digraph %q {", b.ID),
+		fmt.Sprintf(`    %q [label="malware\nPoison Ivy"];`, m.ID),
+		fmt.Sprintf(`    %q [label="identity\nACME"];`, i.ID),
+		fmt.Sprintf(`    %q -> %q [label="targets"];`, m.ID, i.ID),
+		fmt.Sprintf(`    %q -> %q [label="sighted"];`, i.ID, m.ID),
+		fmt.Sprintf(`    %q [label="report\nWeekly"];`, rep.ID),
+		fmt.Sprintf(`    %q -> %q [label="object_refs"];`, rep.ID, m.ID),
+		"}",
+	}
+	got := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+
+	if len(got) != len(want) {
+		t.Fatalf("Fail expected %d lines, got %d:\n%s", len(want), len(got), buf.String())
+	}
+	for n := range want {
+		if got[n] != want[n] {
+			t.Errorf("Fail line %d expected %s, got %s", n, want[n], got[n])
+		}
+	}
+}
+
+/*
+TestToDOTSightingWithoutWhere - Make sure a sighting without where_sighted_refs
+is drawn as its own node with an edge to what was sighted.
+*/
+func TestToDOTSightingWithoutWhere(t *testing.T) {
+	m := malware.New()
+	s := sighting.New()
+	s.SetSightingOfRef(m.ID)
+
+	b := bundle.New()
+	b.AddObject(s)
+
+	var buf bytes.Buffer
+	if err := ToDOT(b, &buf); err != nil {
+		t.Fatalf("Fail unexpected error %v", err)
+	}
+
+	for _, v := range []string{
+		fmt.Sprintf(`    %q [label="sighting"];`, s.ID),
+		fmt.Sprintf(`    %q -> %q [label="sighting-of"];`, s.ID, m.ID),
+	} {
+		if !strings.Contains(buf.String(), v) {
+			t.Errorf("Fail expected the output to contain %s", v)
+		}
+	}
+}
+
+/*
+TestToDOTNilBundle - Make sure a nil bundle is an error.
+*/
+func TestToDOTNilBundle(t *testing.T) {
+	var buf bytes.Buffer
+	if err := ToDOT(nil, &buf); err == nil {
+		t.Error("Fail expected an error for a nil bundle")
+	}
+}
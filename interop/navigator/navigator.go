@@ -0,0 +1,145 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+/*
+Package navigator converts STIX attack-pattern objects into MITRE ATT&CK
+Navigator layer files.
+
+Each attack-pattern that carries an ATT&CK external reference (source_name of
+"mitre-attack", "mitre-mobile-attack", or "mitre-ics-attack") is mapped to a
+technique entry in the layer using its external_id. The score of a technique is
+the number of attack-patterns in the bundle that map to it. Only techniques are
+exported, tactics are not.
+
+The domain of the layer is taken from the source name of those references, so
+"mitre-mobile-attack" gives a "mobile-attack" layer and "mitre-ics-attack" gives
+an "ics-attack" layer. A layer can only have one domain, so a bundle that mixes
+them is an error.
+*/
+package navigator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/freetaxii/libstix2/objects/attackpattern"
+	"github.com/freetaxii/libstix2/objects/bundle"
+)
+
+// These are the layer format values that are written to every layer.
+const (
+	LayerFormatVersion = "4.5"
+	DefaultDomain      = "enterprise-attack"
+)
+
+// attackDomains - These are the external reference source names that carry an
+// ATT&CK technique id in their external_id, and the layer domain of each one.
+var attackDomains = map[string]string{
+	"mitre-attack":        "enterprise-attack",
+	"mitre-mobile-attack": "mobile-attack",
+	"mitre-ics-attack":    "ics-attack",
+}
+
+// ----------------------------------------------------------------------
+// Define Object Model
+// ----------------------------------------------------------------------
+
+/*
+Layer - This type defines the subset of the ATT&CK Navigator layer format that
+is produced by this package.
+*/
+type Layer struct {
+	Name        string        `json:"name"`
+	Versions    LayerVersions `json:"versions"`
+	Domain      string        `json:"domain"`
+	Description string        `json:"description,omitempty"`
+	Techniques  []Technique   `json:"techniques"`
+}
+
+/*
+LayerVersions - This type defines the version information of a layer.
+*/
+type LayerVersions struct {
+	Layer string `json:"layer"`
+}
+
+/*
+Technique - This type defines a single technique entry in a layer.
+*/
+type Technique struct {
+	TechniqueID string `json:"techniqueID"`
+	Score       int    `json:"score"`
+	Comment     string `json:"comment,omitempty"`
+}
+
+// ----------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------
+
+/*
+BuildLayer - This function will take in a bundle and a layer name and return
+an ATT&CK Navigator layer as JSON. Objects that are not attack-patterns, and
+attack-patterns without an ATT&CK external id, are ignored. The domain is taken
+from the ATT&CK references, and is DefaultDomain if there are none. An error is
+returned if the references are from more than one domain. The techniques are
+sorted by technique id so the output is stable.
+*/
+func BuildLayer(b *bundle.Bundle, name string) ([]byte, error) {
+	if b == nil {
+		return nil, errors.New("no bundle was provided to build the layer from")
+	}
+
+	if name == "" {
+		return nil, errors.New("a layer name is required")
+	}
+
+	domain := ""
+	scores := make(map[string]int)
+	comments := make(map[string]string)
+
+	for _, v := range b.Objects {
+		ap, ok := v.(*attackpattern.AttackPattern)
+		if !ok {
+			continue
+		}
+
+		for _, ref := range ap.ExternalReferences {
+			d, found := attackDomains[ref.SourceName]
+			if !found || ref.ExternalID == "" {
+				continue
+			}
+			if domain == "" {
+				domain = d
+			} else if domain != d {
+				return nil, fmt.Errorf("the bundle has techniques from both the %s and %s domains", domain, d)
+			}
+			scores[ref.ExternalID]++
+			if _, found := comments[ref.ExternalID]; !found {
+				comments[ref.ExternalID] = ap.Name
+			}
+		}
+	}
+
+	var layer Layer
+	layer.Name = name
+	layer.Versions.Layer = LayerFormatVersion
+	layer.Domain = domain
+	if layer.Domain == "" {
+		layer.Domain = DefaultDomain
+	}
+	layer.Techniques = make([]Technique, 0, len(scores))
+
+	for id, score := range scores {
+		layer.Techniques = append(layer.Techniques, Technique{TechniqueID: id, Score: score, Comment: comments[id]})
+	}
+
+	sort.Slice(layer.Techniques, func(i, j int) bool {
+		return layer.Techniques[i].TechniqueID < layer.Techniques[j].TechniqueID
+	})
+
+	return json.MarshalIndent(layer, "", "    ")
+}
@@ -0,0 +1,127 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package navigator
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/attackpattern"
+	"github.com/freetaxii/libstix2/objects/bundle"
+	"github.com/freetaxii/libstix2/objects/sco/ipv4addr"
+)
+
+// ----------------------------------------------------------------------
+// Helper Functions
+// ----------------------------------------------------------------------
+
+// newAttackPattern - This function returns an attack-pattern with a single
+// external reference.
+func newAttackPattern(name, source, id string) *attackpattern.AttackPattern {
+	ap := attackpattern.New()
+	ap.SetName(name)
+	ref, _ := ap.NewExternalReference()
+	ref.SetSourceName(source)
+	ref.SetExternalID(id)
+	return ap
+}
+
+// buildLayer - This function builds a layer from the bundle and decodes it.
+func buildLayer(t *testing.T, b *bundle.Bundle) Layer {
+	t.Helper()
+	data, err := BuildLayer(b, "test layer")
+	if err != nil {
+		t.Fatalf("Fail unexpected error %v", err)
+	}
+	var layer Layer
+	if err := json.Unmarshal(data, &layer); err != nil {
+		t.Fatalf("Fail the layer is not valid JSON: %v", err)
+	}
+	return layer
+}
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestBuildLayer - Make sure techniques are scored, sorted, and commented, and
+objects without an ATT&CK reference are ignored.
+*/
+func TestBuildLayer(t *testing.T) {
+	b := bundle.New()
+	b.AddObject(newAttackPattern("Spearphishing", "mitre-attack", "T1566"))
+	b.AddObject(newAttackPattern("Phishing", "mitre-attack", "T1566"))
+	b.AddObject(newAttackPattern("Command Line", "mitre-attack", "T1059"))
+	b.AddObject(newAttackPattern("Local", "capec", "CAPEC-1"))
+	ip := ipv4addr.New()
+	ip.SetValue("198.51.100.3")
+	b.AddObject(ip)
+
+	layer := buildLayer(t, b)
+
+	if layer.Name != "test layer" || layer.Versions.Layer != LayerFormatVersion {
+		t.Errorf("Fail unexpected name %q or version %q", layer.Name, layer.Versions.Layer)
+	}
+	if layer.Domain != "enterprise-attack" {
+		t.Errorf("Fail expected the enterprise-attack domain, got %q", layer.Domain)
+	}
+
+	want := []Technique{
+		{TechniqueID: "T1059", Score: 1, Comment: "Command Line"},
+		{TechniqueID: "T1566", Score: 2, Comment: "Spearphishing"},
+	}
+	if len(layer.Techniques) != len(want) {
+		t.Fatalf("Fail expected %d techniques, got %d", len(want), len(layer.Techniques))
+	}
+	for i, v := range want {
+		if layer.Techniques[i] != v {
+			t.Errorf("Fail expected %+v, got %+v", v, layer.Techniques[i])
+		}
+	}
+}
+
+/*
+TestBuildLayerDomain - Make sure the domain is taken from the ATT&CK references
+and that mixing domains is an error.
+*/
+func TestBuildLayerDomain(t *testing.T) {
+	tests := map[string]string{
+		"mitre-attack":        "enterprise-attack",
+		"mitre-mobile-attack": "mobile-attack",
+		"mitre-ics-attack":    "ics-attack",
+	}
+	for source, domain := range tests {
+		b := bundle.New()
+		b.AddObject(newAttackPattern("Technique", source, "T0001"))
+		if layer := buildLayer(t, b); layer.Domain != domain {
+			t.Errorf("Fail expected %s to give the %s domain, got %q", source, domain, layer.Domain)
+		}
+	}
+
+	if layer := buildLayer(t, bundle.New()); layer.Domain != DefaultDomain || len(layer.Techniques) != 0 {
+		t.Errorf("Fail expected an empty %s layer, got %q with %d techniques", DefaultDomain, layer.Domain, len(layer.Techniques))
+	}
+
+	b := bundle.New()
+	b.AddObject(newAttackPattern("Enterprise", "mitre-attack", "T1566"))
+	b.AddObject(newAttackPattern("Mobile", "mitre-mobile-attack", "T1660"))
+	if _, err := BuildLayer(b, "mixed"); err == nil {
+		t.Error("Fail expected an error for a bundle with more than one domain")
+	}
+}
+
+/*
+TestBuildLayerErrors - Make sure a missing bundle or name is an error.
+*/
+func TestBuildLayerErrors(t *testing.T) {
+	if _, err := BuildLayer(nil, "test"); err == nil {
+		t.Error("Fail expected an error for a nil bundle")
+	}
+	if _, err := BuildLayer(bundle.New(), ""); err == nil {
+		t.Error("Fail expected an error for an empty name")
+	}
+}
@@ -23,6 +23,7 @@ type AttackPattern struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	objects.AliasesProperty
 	objects.KillChainPhasesProperty
 }
@@ -33,7 +34,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *AttackPattern) GetPropertyList() []string {
-	return []string{"name", "description", "aliases", "kill_chain_phases"}
+	return []string{"name", "description", "x_descriptions", "aliases", "kill_chain_phases"}
 }
 
 // ----------------------------------------------------------------------
@@ -50,3 +51,12 @@ func New() *AttackPattern {
 	obj.InitSDO("attack-pattern")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *AttackPattern) Clone() *AttackPattern {
+	return objects.DeepCopy(o).(*AttackPattern)
+}
@@ -25,9 +25,9 @@ func (o *AttackPattern) Valid(debug bool) (bool, int, []string) {
 	resultDetails = append(resultDetails, dBase...)
 
 	// Verify object Name property is present
-	// _, pName, dName := o.NameProperty.VerifyExists()
-	// problemsFound += pName
-	// resultDetails = append(resultDetails, dName...)
+	_, pName, dName := o.NameProperty.VerifyExists()
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
 
 	if problemsFound > 0 {
 		return false, problemsFound, resultDetails
@@ -0,0 +1,264 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/freetaxii/libstix2/objects"
+	"github.com/freetaxii/libstix2/objects/indicator"
+	"github.com/freetaxii/libstix2/objects/malware"
+	"github.com/freetaxii/libstix2/objects/observeddata"
+	"github.com/freetaxii/libstix2/objects/report"
+	"github.com/freetaxii/libstix2/objects/threatactor"
+	"github.com/freetaxii/libstix2/objects/tool"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Version Conversion
+// ----------------------------------------------------------------------
+
+/*
+Upgrade20to21 - This function will take in a STIX 2.0 bundle and return a STIX
+2.1 bundle along with a slice of strings that describes each transformation that
+was applied and each property that could not be mapped. The objects in the
+bundle are updated in place and are shared with the returned bundle.
+
+In STIX 2.0 the spec_version lived on the bundle, in STIX 2.1 it lives on each
+object. Several objects also used the labels property to carry what is now a
+dedicated *_types property. Objects that were decoded as generic common
+properties, because this library does not model them, are left untouched.
+*/
+func Upgrade20to21(b *Bundle) (*Bundle, []string) {
+	changes := make([]string, 0)
+
+	nb := New()
+	nb.SetID(b.GetID())
+
+	if b.SpecVersion != "" {
+		changes = append(changes, fmt.Sprintf("++ removed spec_version %s from the bundle", b.SpecVersion))
+	}
+
+	for _, v := range b.Objects {
+		nb.AddObject(v)
+		c := v.GetCommonProperties()
+
+		if _, ok := v.(*objects.CustomObject); ok {
+			changes = append(changes, fmt.Sprintf("-- %s is not a recognized object type and was left untouched", c.ID))
+			continue
+		}
+
+		if c.SpecVersion == "2.1" {
+			continue
+		}
+
+		c.SetSpecVersion21()
+		changes = append(changes, fmt.Sprintf("++ %s added spec_version 2.1", c.ID))
+
+		switch obj := v.(type) {
+		case *indicator.Indicator:
+			moveLabelsToTypes(c, &obj.IndicatorTypes, "indicator_types", &changes)
+			if obj.PatternType == "" {
+				obj.PatternType = "stix"
+				changes = append(changes, fmt.Sprintf("++ %s set pattern_type to stix", c.ID))
+			}
+		case *malware.Malware:
+			moveLabelsToTypes(c, &obj.MalwareTypes, "malware_types", &changes)
+			if !obj.IsFamily {
+				obj.SetIsFamily()
+				changes = append(changes, fmt.Sprintf("++ %s set is_family to true", c.ID))
+			}
+		case *report.Report:
+			moveLabelsToTypes(c, &obj.ReportTypes, "report_types", &changes)
+		case *threatactor.ThreatActor:
+			moveLabelsToTypes(c, &obj.ThreatActorTypes, "threat_actor_types", &changes)
+		case *tool.Tool:
+			moveLabelsToTypes(c, &obj.ToolTypes, "tool_types", &changes)
+		}
+	}
+
+	return nb, changes
+}
+
+/*
+Downgrade21to20 - This function will take in a STIX 2.1 bundle and return a
+STIX 2.0 bundle along with a slice of strings that describes each
+transformation that was applied. Entries that start with "--" are lossy, they
+record an object or property that was dropped because STIX 2.0 can not
+represent it, so callers can decide whether to use the result. The objects are
+copied with objects.DeepCopy() before they are changed, so the bundle that is
+passed in is left as it was.
+*/
+func Downgrade21to20(b *Bundle) (*Bundle, []string) {
+	changes := make([]string, 0)
+
+	nb := New()
+	nb.SetID(b.GetID())
+	nb.SetSpecVersion20()
+	changes = append(changes, "++ added spec_version 2.0 to the bundle")
+
+	for _, src := range b.Objects {
+		c := src.GetCommonProperties()
+
+		if objectTypesOnlyIn21[c.ObjectType] {
+			changes = append(changes, fmt.Sprintf("-- %s was dropped, the %s object does not exist in STIX 2.0", c.ID, c.ObjectType))
+			continue
+		}
+
+		v := objects.DeepCopy(src).(objects.STIXObject)
+		c = v.GetCommonProperties()
+		nb.AddObject(v)
+
+		if _, ok := v.(*objects.CustomObject); ok {
+			changes = append(changes, fmt.Sprintf("-- %s is not a recognized object type and was left untouched", c.ID))
+			continue
+		}
+
+		if c.SpecVersion != "" {
+			c.SetSpecVersion("")
+			changes = append(changes, fmt.Sprintf("++ %s removed spec_version", c.ID))
+		}
+
+		if len(c.Extensions) > 0 {
+			c.Extensions = nil
+			changes = append(changes, fmt.Sprintf("-- %s dropped extensions", c.ID))
+		}
+
+		if c.Lang != "" {
+			c.Lang = ""
+			changes = append(changes, fmt.Sprintf("-- %s dropped lang", c.ID))
+		}
+
+		if c.Confidence != 0 {
+			c.Confidence = 0
+			changes = append(changes, fmt.Sprintf("-- %s dropped confidence", c.ID))
+		}
+
+		switch obj := v.(type) {
+		case *indicator.Indicator:
+			moveTypesToLabels(c, &obj.IndicatorTypes, "indicator_types", &changes)
+			if obj.PatternType != "" && obj.PatternType != "stix" {
+				changes = append(changes, fmt.Sprintf("-- %s has a %s pattern that STIX 2.0 can not express", c.ID, obj.PatternType))
+			}
+			obj.PatternType = ""
+			if obj.PatternVersion != "" {
+				obj.PatternVersion = ""
+				changes = append(changes, fmt.Sprintf("-- %s dropped pattern_version", c.ID))
+			}
+		case *malware.Malware:
+			moveTypesToLabels(c, &obj.MalwareTypes, "malware_types", &changes)
+			obj.IsFamily = false
+			if len(obj.Aliases) > 0 || obj.FirstSeen != "" || obj.LastSeen != "" ||
+				len(obj.OsExecutionEnvs) > 0 || len(obj.ArchitectureExecutionEnvs) > 0 ||
+				len(obj.ImplementationLanguages) > 0 || len(obj.Capabilities) > 0 ||
+				len(obj.SampleRefs) > 0 {
+				obj.Aliases = nil
+				obj.FirstSeen = ""
+				obj.LastSeen = ""
+				obj.OsExecutionEnvs = nil
+				obj.ArchitectureExecutionEnvs = nil
+				obj.ImplementationLanguages = nil
+				obj.Capabilities = nil
+				obj.SampleRefs = nil
+				changes = append(changes, fmt.Sprintf("-- %s dropped malware properties that only exist in STIX 2.1", c.ID))
+			}
+		case *report.Report:
+			moveTypesToLabels(c, &obj.ReportTypes, "report_types", &changes)
+		case *threatactor.ThreatActor:
+			moveTypesToLabels(c, &obj.ThreatActorTypes, "threat_actor_types", &changes)
+			if obj.FirstSeen != "" || obj.LastSeen != "" {
+				obj.FirstSeen = ""
+				obj.LastSeen = ""
+				changes = append(changes, fmt.Sprintf("-- %s dropped first_seen and last_seen", c.ID))
+			}
+		case *tool.Tool:
+			moveTypesToLabels(c, &obj.ToolTypes, "tool_types", &changes)
+			if len(obj.Aliases) > 0 {
+				obj.Aliases = nil
+				changes = append(changes, fmt.Sprintf("-- %s dropped aliases", c.ID))
+			}
+		case *observeddata.ObservedData:
+			if len(obj.ObjectRefs) > 0 {
+				obj.ObjectRefs = nil
+				changes = append(changes, fmt.Sprintf("-- %s dropped object_refs", c.ID))
+			}
+		}
+	}
+
+	return nb, changes
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+objectTypesOnlyIn21 - These are the object types that were added in STIX 2.1
+and can not be represented in a STIX 2.0 bundle. In STIX 2.0 the cyber
+observable objects could only be found inside of an observed-data object.
+*/
+var objectTypesOnlyIn21 = map[string]bool{
+	"grouping":             true,
+	"infrastructure":       true,
+	"language-content":     true,
+	"location":             true,
+	"malware-analysis":     true,
+	"note":                 true,
+	"opinion":              true,
+	"artifact":             true,
+	"autonomous-system":    true,
+	"directory":            true,
+	"domain-name":          true,
+	"email-addr":           true,
+	"email-message":        true,
+	"file":                 true,
+	"ipv4-addr":            true,
+	"ipv6-addr":            true,
+	"mac-addr":             true,
+	"mutex":                true,
+	"network-traffic":      true,
+	"process":              true,
+	"software":             true,
+	"url":                  true,
+	"user-account":         true,
+	"windows-registry-key": true,
+	"x509-certificate":     true,
+}
+
+/*
+moveLabelsToTypes - This function will move the labels on a STIX 2.0 object to
+the *_types property that replaced them in STIX 2.1. If the *_types property
+is already populated the labels are left alone and this is reported.
+*/
+func moveLabelsToTypes(c *objects.CommonObjectProperties, types *[]string, name string, changes *[]string) {
+	if len(c.Labels) == 0 {
+		return
+	}
+
+	if len(*types) > 0 {
+		*changes = append(*changes, fmt.Sprintf("-- %s already has %s, labels were not mapped", c.ID, name))
+		return
+	}
+
+	*types = c.Labels
+	c.Labels = nil
+	*changes = append(*changes, fmt.Sprintf("++ %s converted labels to %s", c.ID, name))
+}
+
+/*
+moveTypesToLabels - This function will move the *_types property of a STIX 2.1
+object back to the labels property that STIX 2.0 used. Any labels that were
+already on the object are kept after the types.
+*/
+func moveTypesToLabels(c *objects.CommonObjectProperties, types *[]string, name string, changes *[]string) {
+	if len(*types) == 0 {
+		return
+	}
+
+	c.Labels = append(*types, c.Labels...)
+	*types = nil
+	*changes = append(*changes, fmt.Sprintf("++ %s converted %s to labels", c.ID, name))
+}
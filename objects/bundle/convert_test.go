@@ -0,0 +1,156 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/grouping"
+	"github.com/freetaxii/libstix2/objects/malware"
+)
+
+const bundle20 = `{
+    "type": "bundle",
+    "id": "bundle--5d0092c5-5f74-4287-9642-33f4c354e56d",
+    "spec_version": "2.0",
+    "objects": [
+        {
+            "type": "malware",
+            "id": "malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b",
+            "created": "2016-04-06T20:07:09.000Z",
+            "modified": "2016-04-06T20:07:09.000Z",
+            "name": "Poison Ivy",
+            "labels": ["remote-access-trojan"]
+        },
+        {
+            "type": "x-custom-object",
+            "id": "x-custom-object--7c1c3b6d-6d5a-4d3c-9a4f-6c3f1a2f3b4e",
+            "created": "2016-04-06T20:07:09.000Z",
+            "modified": "2016-04-06T20:07:09.000Z"
+        }
+    ]
+}`
+
+// TestUpgrade20to21 - Make sure malware labels are moved to malware_types and
+// spec_version is moved from the bundle to the objects.
+func TestUpgrade20to21(t *testing.T) {
+	b, errs := Decode(strings.NewReader(bundle20))
+	if len(errs) > 0 {
+		t.Fatalf("Fail unable to decode bundle: %v", errs)
+	}
+
+	nb, changes := Upgrade20to21(b)
+	t.Log(changes)
+
+	if nb.SpecVersion != "" {
+		t.Error("Fail a STIX 2.1 bundle should not have a spec_version")
+	}
+
+	m, ok := nb.Objects[0].(*malware.Malware)
+	if !ok {
+		t.Fatal("Fail first object was not decoded as malware")
+	}
+
+	if m.SpecVersion != "2.1" {
+		t.Error("Fail malware spec_version was not set to 2.1")
+	}
+
+	if len(m.MalwareTypes) != 1 || m.MalwareTypes[0] != "remote-access-trojan" || len(m.Labels) != 0 {
+		t.Error("Fail malware labels were not converted to malware_types")
+	}
+
+	if !m.IsFamily {
+		t.Error("Fail malware is_family was not set")
+	}
+
+	if valid, _, details := m.Valid(false); !valid {
+		t.Errorf("Fail upgraded malware is not valid: %v", details)
+	}
+
+	if nb.Objects[1].GetCommonProperties().SpecVersion != "" {
+		t.Error("Fail unrecognized object should be left untouched")
+	}
+}
+
+// TestDowngrade21to20 - Make sure 2.1 only objects are dropped and flagged as
+// lossy, and malware_types are moved back to labels.
+func TestDowngrade21to20(t *testing.T) {
+	b := New()
+	m := malware.New()
+	m.SetName("Poison Ivy")
+	m.AddTypes("remote-access-trojan")
+	m.SetIsFamily()
+	b.AddObject(m)
+	b.AddObject(grouping.New())
+
+	nb, changes := Downgrade21to20(b)
+	t.Log(changes)
+
+	if nb.SpecVersion != "2.0" {
+		t.Error("Fail a STIX 2.0 bundle should have a spec_version of 2.0")
+	}
+
+	if len(nb.Objects) != 1 {
+		t.Fatalf("Fail expected the grouping to be dropped, got %d objects", len(nb.Objects))
+	}
+
+	nm, ok := nb.Objects[0].(*malware.Malware)
+	if !ok || nm.SpecVersion != "" || len(nm.MalwareTypes) != 0 || len(nm.Labels) != 1 {
+		t.Error("Fail malware was not converted to STIX 2.0")
+	}
+
+	if m.SpecVersion != "2.1" || len(m.MalwareTypes) != 1 || len(m.Labels) != 0 {
+		t.Error("Fail the source malware should not be changed")
+	}
+
+	lossy := 0
+	for _, v := range changes {
+		if strings.HasPrefix(v, "--") {
+			lossy++
+		}
+	}
+	if lossy != 1 {
+		t.Errorf("Fail expected 1 lossy conversion, got %d", lossy)
+	}
+}
+
+// TestDowngrade21to20Common - Make sure the 2.1 only common properties are
+// dropped as lossy and the source bundle is not changed.
+func TestDowngrade21to20Common(t *testing.T) {
+	b := New()
+	m := malware.New()
+	m.SetName("Poison Ivy")
+	m.SetLang("en")
+	m.SetConfidence(80)
+	m.Extensions = map[string]interface{}{"x-acme-ext": map[string]interface{}{"extension_type": "property-extension"}}
+	b.AddObject(m)
+
+	nb, changes := Downgrade21to20(b)
+
+	nm := nb.Objects[0].(*malware.Malware)
+	if nm == m {
+		t.Fatal("Fail the downgraded object should be a copy")
+	}
+	if nm.Lang != "" || nm.Confidence != 0 || nm.Extensions != nil {
+		t.Error("Fail lang, confidence, and extensions should be dropped")
+	}
+	if m.Lang != "en" || m.Confidence != 80 || m.Extensions == nil || m.SpecVersion != "2.1" {
+		t.Error("Fail the source malware should not be changed")
+	}
+
+	for _, want := range []string{"dropped lang", "dropped confidence", "dropped extensions"} {
+		found := false
+		for _, v := range changes {
+			if strings.HasPrefix(v, "--") && strings.HasSuffix(v, want) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("Fail expected a lossy entry for %s, got %v", want, changes)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// volatileProperties - These are the properties that are left out of the
+// content hash, as they differ between copies of the same object.
+var volatileProperties = []string{"id", "created", "modified"}
+
+// ----------------------------------------------------------------------
+// Public Methods - De-duplication
+// ----------------------------------------------------------------------
+
+/*
+DedupeByContent - This method will remove the objects whose content is the
+same as an object earlier in the bundle, even if their ids differ. This is
+common with feeds that give SCOs random ids. The content is compared as
+canonical JSON without the id, created, and modified properties, so run
+objects.Normalize() on the objects first to also catch differences in white
+space or timestamp precision. Objects that can not be encoded are kept. It will
+return the number of objects that were removed.
+*/
+func (o *Bundle) DedupeByContent() int {
+	seen := make(map[[sha256.Size]byte]bool)
+	kept := o.Objects[:0]
+	removed := 0
+
+	for _, v := range o.Objects {
+		hash, err := contentHash(v)
+		if err != nil {
+			kept = append(kept, v)
+			continue
+		}
+		if seen[hash] {
+			removed++
+			continue
+		}
+		seen[hash] = true
+		kept = append(kept, v)
+	}
+
+	for i := len(kept); i < len(o.Objects); i++ {
+		o.Objects[i] = nil
+	}
+	o.Objects = kept
+	return removed
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+contentHash - This function will return the SHA-256 hash of the canonical JSON
+of the object without its volatile properties.
+*/
+func contentHash(obj objects.STIXObject) ([sha256.Size]byte, error) {
+	var hash [sha256.Size]byte
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return hash, err
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return hash, err
+	}
+	for _, name := range volatileProperties {
+		delete(m, name)
+	}
+
+	if data, err = json.Marshal(m); err != nil {
+		return hash, err
+	}
+	if data, err = objects.CanonicalizeJSON(data); err != nil {
+		return hash, err
+	}
+	return sha256.Sum256(data), nil
+}
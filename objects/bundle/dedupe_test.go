@@ -0,0 +1,45 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/sco/ipv4addr"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestDedupeByContent - Make sure objects that only differ by id are removed and
+the first one is kept.
+*/
+func TestDedupeByContent(t *testing.T) {
+	a := ipv4addr.New()
+	a.SetValue("198.51.100.3")
+	b := ipv4addr.New()
+	b.SetValue("198.51.100.3")
+	c := ipv4addr.New()
+	c.SetValue("198.51.100.4")
+
+	bun := New()
+	bun.AddObject(a)
+	bun.AddObject(b)
+	bun.AddObject(c)
+
+	if removed := bun.DedupeByContent(); removed != 1 {
+		t.Errorf("Fail expected 1 object to be removed, got %d", removed)
+	}
+	if len(bun.Objects) != 2 || bun.Objects[0].GetCommonProperties().ID != a.ID || bun.Objects[1].GetCommonProperties().ID != c.ID {
+		t.Error("Fail expected the first copy and the different object to be kept")
+	}
+
+	if removed := bun.DedupeByContent(); removed != 0 {
+		t.Errorf("Fail expected nothing to be removed the second time, got %d", removed)
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"sort"
+	"time"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Diff
+// ----------------------------------------------------------------------
+
+/*
+DiffBundles - This function will compare two snapshots of the same data and
+return the ids of the objects that were added, modified, and removed between
+the old bundle and the new bundle. An object is modified when its id is in
+both bundles and the new bundle has a later modified timestamp for it. If a
+bundle has more than one version of an object, the latest one is used. Each
+of the returned slices is sorted.
+*/
+func DiffBundles(prev, cur *Bundle) (added, modified, removed []string) {
+	oldVersions := latestVersions(prev)
+	newVersions := latestVersions(cur)
+
+	added = make([]string, 0)
+	modified = make([]string, 0)
+	removed = make([]string, 0)
+
+	for id, nv := range newVersions {
+		ov, found := oldVersions[id]
+		if !found {
+			added = append(added, id)
+			continue
+		}
+		if nv.After(ov) {
+			modified = append(modified, id)
+		}
+	}
+
+	for id := range oldVersions {
+		if _, found := newVersions[id]; !found {
+			removed = append(removed, id)
+		}
+	}
+
+	sort.Strings(added)
+	sort.Strings(modified)
+	sort.Strings(removed)
+	return added, modified, removed
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+latestVersions - This function will return a map of each object id in the
+bundle to the latest modified timestamp found for it. Objects without a
+modified timestamp, like cyber observables, get the zero time.
+*/
+func latestVersions(b *Bundle) map[string]time.Time {
+	m := make(map[string]time.Time)
+	if b == nil {
+		return m
+	}
+
+	for _, v := range b.Objects {
+		c := v.GetCommonProperties()
+		t, _ := time.Parse(time.RFC3339, c.Modified)
+		if cur, found := m[c.ID]; !found || t.After(cur) {
+			m[c.ID] = t
+		}
+	}
+	return m
+}
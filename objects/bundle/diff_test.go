@@ -0,0 +1,47 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// TestDiffBundles - Make sure added, modified, and removed objects are found
+// and that unchanged objects are not reported.
+func TestDiffBundles(t *testing.T) {
+	same := indicator.New()
+	changed := indicator.New()
+	changed.SetModified("2020-01-01T00:00:00.000Z")
+	gone := indicator.New()
+
+	old := New()
+	old.AddObject(same)
+	old.AddObject(changed)
+	old.AddObject(gone)
+
+	changed2 := changed.Clone()
+	changed2.SetModified("2021-01-01T00:00:00.000Z")
+	fresh := indicator.New()
+
+	cur := New()
+	cur.AddObject(same)
+	cur.AddObject(changed2)
+	cur.AddObject(fresh)
+
+	added, modified, removed := DiffBundles(old, cur)
+
+	if len(added) != 1 || added[0] != fresh.ID {
+		t.Errorf("Fail added is wrong: %v", added)
+	}
+	if len(modified) != 1 || modified[0] != changed.ID {
+		t.Errorf("Fail modified is wrong: %v", modified)
+	}
+	if len(removed) != 1 || removed[0] != gone.ID {
+		t.Errorf("Fail removed is wrong: %v", removed)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Compression
+// ----------------------------------------------------------------------
+
+/*
+WriteGzip - This function will encode a bundle as JSON and write it to w using
+gzip compression at the level given. The level can be any of the values
+accepted by the compress/gzip package, like gzip.DefaultCompression or
+gzip.BestCompression.
+*/
+func WriteGzip(w io.Writer, b *Bundle, level int) error {
+	zw, err := gzip.NewWriterLevel(w, level)
+	if err != nil {
+		return err
+	}
+
+	if err := json.NewEncoder(zw).Encode(b); err != nil {
+		zw.Close()
+		return err
+	}
+
+	return zw.Close()
+}
+
+/*
+ReadGzip - This function will read a gzip compressed bundle from r and decode
+it. It will return the bundle as a pointer along with any errors found, the
+same way that Decode() does.
+*/
+func ReadGzip(r io.Reader) (*Bundle, []error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, []error{err}
+	}
+	defer zr.Close()
+
+	return Decode(zr)
+}
@@ -0,0 +1,66 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// TestGzipRoundTrip - Make sure a bundle written with WriteGzip can be read
+// back with ReadGzip without losing any objects.
+func TestGzipRoundTrip(t *testing.T) {
+	b := New()
+	i := indicator.New()
+	i.SetName("Malicious site")
+	i.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+	b.AddObject(i)
+
+	var buf bytes.Buffer
+	if err := WriteGzip(&buf, b, gzip.BestCompression); err != nil {
+		t.Fatalf("Fail WriteGzip returned an error: %v", err)
+	}
+
+	b2, errs := ReadGzip(&buf)
+	if len(errs) > 0 {
+		t.Fatalf("Fail ReadGzip returned errors: %v", errs)
+	}
+
+	if b2.ID != b.ID {
+		t.Errorf("Fail bundle id does not match: %s | %s", b.ID, b2.ID)
+	}
+
+	if len(b2.Objects) != 1 {
+		t.Fatalf("Fail expected 1 object in bundle, got %d", len(b2.Objects))
+	}
+
+	i2, ok := b2.Objects[0].(*indicator.Indicator)
+	if !ok {
+		t.Fatalf("Fail object was not decoded as an indicator")
+	}
+
+	if i2.ID != i.ID || i2.Pattern != i.Pattern {
+		t.Error("Fail indicator did not survive the round trip")
+	}
+}
+
+// TestGzipInvalidLevel - Make sure an invalid compression level is reported.
+func TestGzipInvalidLevel(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGzip(&buf, New(), 42); err == nil {
+		t.Error("Fail WriteGzip should reject an invalid compression level")
+	}
+}
+
+// TestReadGzipNotCompressed - Make sure plain JSON is rejected by ReadGzip.
+func TestReadGzipNotCompressed(t *testing.T) {
+	if _, errs := ReadGzip(bytes.NewBufferString(`{"type": "bundle"}`)); len(errs) == 0 {
+		t.Error("Fail ReadGzip should reject data that is not gzip compressed")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/freetaxii/libstix2/objects/infrastructure"
 	"github.com/freetaxii/libstix2/objects/intrusionset"
 	"github.com/freetaxii/libstix2/objects/malware"
+	"github.com/freetaxii/libstix2/objects/markingdefinition"
 	"github.com/freetaxii/libstix2/objects/observeddata"
 	"github.com/freetaxii/libstix2/objects/relationship"
 	"github.com/freetaxii/libstix2/objects/report"
@@ -46,143 +47,109 @@ func Decode(r io.Reader) (*Bundle, []error) {
 	err := json.NewDecoder(r).Decode(&rawBundle)
 	if err != nil {
 		// If we can not decode the outer Bundle, we can not do anything so return
+		objects.GetLogger().Error("unable to decode bundle", "error", err)
 		allErrors = append(allErrors, err)
 		return nil, allErrors
 	}
 
-	// Populate the ID just in case a client needs or wants it
+	// Populate the type and ID just in case a client needs or wants them. The
+	// spec_version is only found on STIX 2.0 bundles and is kept so they can be
+	// upgraded.
+	b.ObjectType = rawBundle.ObjectType
 	b.SetID(rawBundle.GetID())
+	b.SpecVersion = rawBundle.GetSpecVersion()
 
 	// Loop through all of the raw objects and decode them
-	for _, v := range rawBundle.Objects {
+	for i, v := range rawBundle.Objects {
 
 		// Make a first pass to decode just the object type value. Once we have this
 		// value we can easily make a second pass and decode the rest of the object.
 		stixtype, err := objects.DecodeType(v)
 		if err != nil {
+			objects.GetLogger().Error("unable to decode object type in bundle", "bundle", b.ID, "index", i, "error", err)
 			allErrors = append(allErrors, err)
 			return nil, allErrors
 		}
 
-		switch stixtype {
-		case "attack-pattern":
-			obj, err := attackpattern.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "campaign":
-			obj, err := campaign.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "course-of-action":
-			obj, err := courseofaction.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "identity":
-			obj, err := identity.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "indicator":
-			obj, err := indicator.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "infrastructure":
-			obj, err := infrastructure.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "intrusion-set":
-			obj, err := intrusionset.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "malware":
-			obj, err := malware.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "observed-data":
-			obj, err := observeddata.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "relationship":
-			obj, err := relationship.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "report":
-			obj, err := report.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "sighting":
-			obj, err := sighting.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "threat-actor":
-			obj, err := threatactor.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "tool":
-			obj, err := tool.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		case "vulnerability":
-			obj, err := vulnerability.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
-		default:
-			obj, err := objects.Decode(v)
-			if err != nil {
-				allErrors = append(allErrors, err)
-				continue
-			}
-			b.AddObject(obj)
+		obj, err := decodeObjectOfType(stixtype, v)
+		if err != nil {
+			objects.GetLogger().Warn("skipped malformed object while decoding bundle", "bundle", b.ID, "index", i, "type", stixtype, "error", err)
+			allErrors = append(allErrors, err)
+			continue
+		}
+
+		if id := obj.GetCommonProperties().GetID(); !objects.ValidID(id) {
+			objects.GetLogger().Warn("decoded object with an invalid identifier", "bundle", b.ID, "index", i, "id", id)
 		}
+		b.AddObject(obj)
 	}
 
+	objects.GetLogger().Debug("decoded bundle", "bundle", b.ID, "objects", len(b.Objects), "errors", len(allErrors))
+
 	return &b, allErrors
 }
 
+/*
+DecodeObject - This function will take in a slice of bytes representing a
+single STIX object encoded as JSON and decode it in to the right object type.
+Objects of a type that this library does not know about are decoded as an
+*objects.CustomObject, which keeps all of their properties.
+*/
+func DecodeObject(data []byte) (objects.STIXObject, error) {
+	stixtype, err := objects.DecodeType(data)
+	if err != nil {
+		return nil, err
+	}
+	return decodeObjectOfType(stixtype, data)
+}
+
+// ----------------------------------------------------------------------
+// Private Functions - JSON Decoder
+// ----------------------------------------------------------------------
+
+/*
+decodeObjectOfType - This function will dispatch the data to the decoder for
+the STIX object type that was found in it. Types that do not have a decoder
+are decoded as an objects.CustomObject so that none of their data is lost.
+*/
+func decodeObjectOfType(stixtype string, data []byte) (objects.STIXObject, error) {
+	switch stixtype {
+	case "attack-pattern":
+		return attackpattern.Decode(data)
+	case "campaign":
+		return campaign.Decode(data)
+	case "course-of-action":
+		return courseofaction.Decode(data)
+	case "identity":
+		return identity.Decode(data)
+	case "indicator":
+		return indicator.Decode(data)
+	case "infrastructure":
+		return infrastructure.Decode(data)
+	case "intrusion-set":
+		return intrusionset.Decode(data)
+	case "malware":
+		return malware.Decode(data)
+	case "marking-definition":
+		return markingdefinition.Decode(data)
+	case "observed-data":
+		return observeddata.Decode(data)
+	case "relationship":
+		return relationship.Decode(data)
+	case "report":
+		return report.Decode(data)
+	case "sighting":
+		return sighting.Decode(data)
+	case "threat-actor":
+		return threatactor.Decode(data)
+	case "tool":
+		return tool.Decode(data)
+	case "vulnerability":
+		return vulnerability.Decode(data)
+	}
+	return objects.DecodeCustom(data)
+}
+
 // ----------------------------------------------------------------------
 // Public Methods JSON Encoders
 // The encoding is done here at the individual object level instead of at
@@ -216,3 +183,11 @@ func (o *Bundle) EncodeToString() (string, error) {
 	}
 	return string(data), nil
 }
+
+/*
+EncodeWithOptions - This method will encode the object into JSON using the
+options given, see objects.MarshalOptions.
+*/
+func (o *Bundle) EncodeWithOptions(opts objects.MarshalOptions) ([]byte, error) {
+	return objects.EncodeWithOptions(o, opts)
+}
@@ -0,0 +1,71 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// testLogger - This type records the messages that are sent to it.
+type testLogger struct {
+	warnings []string
+}
+
+func (l *testLogger) Debug(msg string, keyvals ...interface{}) {}
+func (l *testLogger) Info(msg string, keyvals ...interface{})  {}
+func (l *testLogger) Warn(msg string, keyvals ...interface{})  { l.warnings = append(l.warnings, msg) }
+func (l *testLogger) Error(msg string, keyvals ...interface{}) {}
+
+// TestDecodeLogsSkippedObjects - Make sure a malformed object is skipped,
+// returned as an error, and reported to the logger.
+func TestDecodeLogsSkippedObjects(t *testing.T) {
+	l := &testLogger{}
+	objects.SetLogger(l)
+	defer objects.SetLogger(nil)
+
+	data := `{"type": "bundle", "id": "bundle--5d0092c5-5f74-4287-9642-33f4c354e56d", "objects": [
+		{"type": "indicator", "id": "indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f", "pattern": 5},
+		{"type": "tool", "spec_version": "2.1", "id": "tool--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f", "name": "nc"}
+	]}`
+
+	b, errs := Decode(strings.NewReader(data))
+	if len(errs) != 1 {
+		t.Fatalf("Fail expected 1 error, got %d", len(errs))
+	}
+
+	if len(b.Objects) != 1 {
+		t.Errorf("Fail expected the good object to be decoded, got %d objects", len(b.Objects))
+	}
+
+	if len(l.warnings) != 1 {
+		t.Errorf("Fail expected 1 warning to be logged, got %d", len(l.warnings))
+	}
+}
+
+// TestEncodeCustomObjectWithoutEscaping - Make sure a bundle with a custom
+// object does not escape HTML characters with the default options.
+func TestEncodeCustomObjectWithoutEscaping(t *testing.T) {
+	data := `{"type":"x-acme-widget","spec_version":"2.1","id":"x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b","created":"2016-04-06T20:03:48.000Z","modified":"2016-04-06T20:03:48.000Z","rule":"a < b & c"}`
+
+	o, err := DecodeObject([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := New()
+	b.AddObject(o)
+
+	out, err := b.EncodeWithOptions(objects.MarshalOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `"rule":"a < b & c"`) {
+		t.Errorf("Fail expected the value to not be escaped in %s", out)
+	}
+}
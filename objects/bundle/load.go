@@ -0,0 +1,107 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Loading
+// ----------------------------------------------------------------------
+
+/*
+LoadBundleFile - This function will read a bundle from a file, decode it, and
+check it in one step. Files that end in .gz are read with ReadGzip(). The
+bundle is checked with Valid(), each object that has its own Valid() method is
+checked with it, and the references are checked with CheckReferences(). The
+errors and warnings that were found are returned as "-- " and "** " detailed
+results, along with the objects that could not be decoded. In strict mode any
+error, object that could not be decoded, or reference that is not in the
+bundle is also returned as an error. An error is always returned if the file
+can not be read or the bundle can not be decoded at all.
+*/
+func LoadBundleFile(path string, strict bool) (*Bundle, []string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	var b *Bundle
+	var errs []error
+	if strings.HasSuffix(path, ".gz") {
+		b, errs = ReadGzip(r)
+	} else {
+		b, errs = Decode(r)
+	}
+	if b == nil {
+		if len(errs) > 0 {
+			return nil, nil, errs[0]
+		}
+		return nil, nil, fmt.Errorf("unable to decode the bundle in %s", path)
+	}
+
+	problemsFound := len(errs)
+	resultDetails := make([]string, 0)
+	for _, err := range errs {
+		resultDetails = append(resultDetails, fmt.Sprintf("-- An object could not be decoded: %v", err))
+	}
+
+	_, p, d := b.Valid(false)
+	problemsFound += p
+	resultDetails = append(resultDetails, withoutPassed(d)...)
+
+	// SCOs are already checked with their own Valid() by the bundle
+	for _, v := range b.Objects {
+		c := v.GetCommonProperties()
+		obj, ok := v.(validator)
+		if !ok || objects.IsSCOType(c.ObjectType) {
+			continue
+		}
+
+		_, p, d := obj.Valid(false)
+		problemsFound += p
+		for _, str := range withoutPassed(d) {
+			if len(str) > 3 {
+				str = str[:3] + c.ID + ": " + str[3:]
+			}
+			resultDetails = append(resultDetails, str)
+		}
+	}
+
+	refs := b.CheckReferences()
+	resultDetails = append(resultDetails, refs...)
+
+	if strict && problemsFound+len(refs) > 0 {
+		return b, resultDetails, fmt.Errorf("the bundle in %s has %d problems and %d missing references", path, problemsFound, len(refs))
+	}
+	return b, resultDetails, nil
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+withoutPassed - This function will return the detailed results without the
+"++ " entries, as some Valid() methods add them even when debug is false.
+*/
+func withoutPassed(details []string) []string {
+	out := make([]string, 0, len(details))
+	for _, v := range details {
+		if !strings.HasPrefix(v, "++") {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,78 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/relationship"
+	"github.com/freetaxii/libstix2/objects/sco/ipv4addr"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestLoadBundleFile - Make sure a bundle is loaded and checked, that a missing
+reference is only an error in strict mode, and that a file that is not a
+bundle is an error.
+*/
+func TestLoadBundleFile(t *testing.T) {
+	dir := t.TempDir()
+
+	a := ipv4addr.New()
+	a.SetValue("198.51.100.3")
+	c := ipv4addr.New()
+	c.SetValue("198.51.100.4")
+
+	r := relationship.New()
+	r.SetType("related-to")
+	r.SetSourceTarget(a.ID, "malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b")
+
+	b := New()
+	b.AddObject(a)
+	b.AddObject(c)
+	b.AddObject(r)
+
+	write := func(name string) string {
+		data, err := b.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, data, 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	path := write("dangling.json")
+	loaded, details, err := LoadBundleFile(path, false)
+	if err != nil || loaded == nil || len(loaded.Objects) != 3 {
+		t.Fatalf("Fail the bundle should load without strict mode: %v", err)
+	}
+	if len(details) != 1 {
+		t.Errorf("Fail expected the missing reference to be reported, got %v", details)
+	}
+
+	if _, _, err := LoadBundleFile(path, true); err == nil {
+		t.Error("Fail a missing reference should be an error in strict mode")
+	}
+
+	r.SetTargetRef(c.ID)
+	if _, details, err := LoadBundleFile(write("complete.json"), true); err != nil {
+		t.Errorf("Fail a complete bundle should load in strict mode: %v %v", err, details)
+	}
+
+	bad := filepath.Join(dir, "bad.json")
+	os.WriteFile(bad, []byte("not json"), 0o600)
+	if _, _, err := LoadBundleFile(bad, false); err == nil {
+		t.Error("Fail a file that is not a bundle should be an error")
+	}
+}
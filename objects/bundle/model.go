@@ -49,3 +49,13 @@ func New() *Bundle {
 	obj.InitBundle()
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original. Each of the objects in the
+bundle is also copied.
+*/
+func (o *Bundle) Clone() *Bundle {
+	return objects.DeepCopy(o).(*Bundle)
+}
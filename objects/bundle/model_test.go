@@ -0,0 +1,33 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// TestClone - Make sure a cloned bundle has its own copy of each object.
+func TestClone(t *testing.T) {
+	b := New()
+	i := indicator.New()
+	i.AddTypes("malicious-activity")
+	b.AddObject(i)
+
+	c := b.Clone()
+	ci := c.Objects[0].(*indicator.Indicator)
+	ci.IndicatorTypes[0] = "benign"
+	ci.SetName("changed")
+
+	if i.IndicatorTypes[0] != "malicious-activity" || i.Name != "" {
+		t.Error("Fail changing the clone changed the original indicator")
+	}
+
+	if c.ID != b.ID || ci.ID != i.ID {
+		t.Error("Fail ids were not copied")
+	}
+}
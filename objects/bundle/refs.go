@@ -0,0 +1,130 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// requiredAnalysisRefs - These are the references of a malware analysis that
+// must be in the same bundle, see CheckReferences().
+var requiredAnalysisRefs = map[string]bool{
+	"sample_ref":        true,
+	"analysis_sco_refs": true,
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - References
+// ----------------------------------------------------------------------
+
+/*
+CheckReferences - This method will look at every *_ref and *_refs property of
+the objects in the bundle, including the ones nested in properties like
+granular_markings, and report the references to objects that are not in the
+bundle. References to the canonical TLP marking definitions are not reported,
+as they are not expected to be sent. A reference that can not be resolved is
+allowed by the specification, so each one is a "** " warning. The exception is
+the sample_ref and analysis_sco_refs of a malware analysis, as an analysis
+package without the SCOs that it describes is incomplete, so those are "-- "
+errors.
+*/
+func (o *Bundle) CheckReferences() []string {
+	ids := make(map[string]bool, len(o.Objects))
+	for _, v := range o.Objects {
+		ids[v.GetCommonProperties().ID] = true
+	}
+
+	resultDetails := make([]string, 0)
+	for _, v := range o.Objects {
+		c := v.GetCommonProperties()
+		for _, ref := range objectReferences(v) {
+			if ids[ref.target] {
+				continue
+			}
+			if _, found := objects.TLPLevelForID(ref.target); found {
+				continue
+			}
+			prefix := "**"
+			if c.ObjectType == "malware-analysis" && requiredAnalysisRefs[ref.property] {
+				prefix = "--"
+			}
+			str := fmt.Sprintf("%s %s: the %s reference %s is not in the bundle", prefix, c.ID, ref.property, ref.target)
+			resultDetails = append(resultDetails, str)
+		}
+	}
+	return resultDetails
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+reference - This type is a single reference from an object, the property is
+the JSON name of the property that holds it.
+*/
+type reference struct {
+	property string
+	target   string
+}
+
+/*
+objectReferences - This function will return the references of the object,
+sorted by property name so that the results are always in the same order.
+*/
+func objectReferences(obj objects.STIXObject) []reference {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil
+	}
+
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil
+	}
+
+	refs := make([]reference, 0)
+	collectReferences(m, &refs)
+	sort.SliceStable(refs, func(i, j int) bool { return refs[i].property < refs[j].property })
+	return refs
+}
+
+/*
+collectReferences - This function will walk the decoded JSON value and add
+the values of the *_ref and *_refs properties that it finds to refs.
+*/
+func collectReferences(v interface{}, refs *[]reference) {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			switch {
+			case strings.HasSuffix(k, "_ref"):
+				if s, ok := child.(string); ok && s != "" {
+					*refs = append(*refs, reference{k, s})
+				}
+			case strings.HasSuffix(k, "_refs"):
+				if list, ok := child.([]interface{}); ok {
+					for _, item := range list {
+						if s, ok := item.(string); ok && s != "" {
+							*refs = append(*refs, reference{k, s})
+						}
+					}
+				}
+			default:
+				collectReferences(child, refs)
+			}
+		}
+	case []interface{}:
+		for _, child := range val {
+			collectReferences(child, refs)
+		}
+	}
+}
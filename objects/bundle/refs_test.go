@@ -0,0 +1,66 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+	"github.com/freetaxii/libstix2/objects/malwareanalysis"
+	"github.com/freetaxii/libstix2/objects/relationship"
+	"github.com/freetaxii/libstix2/objects/sco/file"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestCheckReferences - Make sure references to objects that are not in the
+bundle are reported, and that TLP markings and objects in the bundle are not.
+*/
+func TestCheckReferences(t *testing.T) {
+	i := indicator.New()
+	i.MarkTLP("green")
+
+	r := relationship.New()
+	r.SetType("indicates")
+	r.SetSourceRef(i.ID)
+	r.SetTargetRef("malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b")
+
+	b := New()
+	b.AddObject(i)
+	b.AddObject(r)
+
+	got := b.CheckReferences()
+	want := "** " + r.ID + ": the target_ref reference malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b is not in the bundle"
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("Fail expected only the target_ref to be reported, got %v", got)
+	}
+}
+
+/*
+TestCheckReferencesMalwareAnalysis - Make sure the sample and analysis SCOs of
+a malware analysis that are not in the bundle are reported as errors.
+*/
+func TestCheckReferencesMalwareAnalysis(t *testing.T) {
+	sample := file.New()
+	sample.SetName("evil.exe")
+
+	m := malwareanalysis.New()
+	m.SetSampleRef(sample.ID)
+	m.AddAnalysisSCORefs("ipv4-addr--ff26c055-6336-5bc5-b98d-13d6226742dd")
+
+	b := New()
+	b.AddObject(sample)
+	b.AddObject(m)
+
+	got := b.CheckReferences()
+	want := "-- " + m.ID + ": the analysis_sco_refs reference ipv4-addr--ff26c055-6336-5bc5-b98d-13d6226742dd is not in the bundle"
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("Fail expected only the analysis_sco_refs to be reported, got %v", got)
+	}
+}
@@ -5,7 +5,11 @@
 
 package bundle
 
-import "github.com/freetaxii/libstix2/objects"
+import (
+	"fmt"
+
+	"github.com/freetaxii/libstix2/objects"
+)
 
 // ----------------------------------------------------------------------
 // Public Methods
@@ -19,3 +23,31 @@ func (o *Bundle) AddObject(i objects.STIXObject) error {
 	o.Objects = append(o.Objects, i)
 	return nil
 }
+
+/*
+SetSpecVersion - This method takes in a STIX version, either 2.0 or 2.1, and
+makes the bundle and its objects consistent with it. STIX 2.0 bundles carry the
+spec_version and their objects do not, so for 2.0 the bundle is stamped and the
+property is removed from the objects. STIX 2.1 is the reverse, each object is
+stamped and the property is removed from the bundle. Only the spec_version is
+changed, see Downgrade21to20() and Upgrade20to21() to convert the rest of the
+objects.
+*/
+func (o *Bundle) SetSpecVersion(s string) error {
+	if s != "2.0" && s != "2.1" {
+		return fmt.Errorf("the spec version %q is not supported, it must be 2.0 or 2.1", s)
+	}
+
+	objectVersion := s
+	if s == "2.0" {
+		o.SpecVersion = s
+		objectVersion = ""
+	} else {
+		o.SpecVersion = ""
+	}
+
+	for _, v := range o.Objects {
+		v.GetCommonProperties().SpecVersion = objectVersion
+	}
+	return nil
+}
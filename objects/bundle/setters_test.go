@@ -0,0 +1,50 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestSetSpecVersion - Make sure the spec_version is moved between the bundle
+and its objects and that the result is valid.
+*/
+func TestSetSpecVersion(t *testing.T) {
+	b := New()
+	b.AddObject(indicator.New())
+	b.AddObject(indicator.New())
+
+	if err := b.SetSpecVersion("2.0"); err != nil {
+		t.Fatal(err)
+	}
+	if b.SpecVersion != "2.0" || b.Objects[0].GetCommonProperties().SpecVersion != "" {
+		t.Error("Fail a STIX 2.0 bundle should carry the spec_version and its objects should not")
+	}
+	if valid, _, details := b.Valid(false); !valid {
+		t.Errorf("Fail the STIX 2.0 bundle should be valid: %v", details)
+	}
+
+	if err := b.SetSpecVersion("2.1"); err != nil {
+		t.Fatal(err)
+	}
+	if b.SpecVersion != "" || b.Objects[1].GetCommonProperties().SpecVersion != "2.1" {
+		t.Error("Fail a STIX 2.1 bundle should not carry the spec_version and its objects should")
+	}
+	if valid, _, details := b.Valid(false); !valid {
+		t.Errorf("Fail the STIX 2.1 bundle should be valid: %v", details)
+	}
+
+	if err := b.SetSpecVersion("2.2"); err == nil {
+		t.Error("Fail an unknown spec version should be rejected")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"sort"
+	"time"
+)
+
+// ----------------------------------------------------------------------
+// Public Methods - Ordering
+// ----------------------------------------------------------------------
+
+/*
+Sort - This method will order the objects in the bundle by type, then id, then
+modified timestamp, so that bundles with the same objects are always written
+the same way no matter what order the objects were added in. Combined with
+canonical JSON this gives byte-stable output. Objects that are equal on all
+three keep their current order.
+*/
+func (o *Bundle) Sort() {
+	sort.SliceStable(o.Objects, func(i, j int) bool {
+		a := o.Objects[i].GetCommonProperties()
+		b := o.Objects[j].GetCommonProperties()
+
+		if a.ObjectType != b.ObjectType {
+			return a.ObjectType < b.ObjectType
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		return modifiedBefore(a.Modified, b.Modified)
+	})
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+modifiedBefore - This function will return true if timestamp a is before
+timestamp b. Timestamps are compared as times so that different precisions
+sort correctly, and fall back to a string compare if either can not be parsed.
+*/
+func modifiedBefore(a, b string) bool {
+	ta, errA := time.Parse(time.RFC3339Nano, a)
+	tb, errB := time.Parse(time.RFC3339Nano, b)
+	if errA != nil || errB != nil {
+		return a < b
+	}
+	return ta.Before(tb)
+}
@@ -0,0 +1,56 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+	"github.com/freetaxii/libstix2/objects/report"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestSort - Make sure bundles with the same objects added in a different order
+encode to the same JSON once sorted.
+*/
+func TestSort(t *testing.T) {
+	i1 := indicator.New()
+	i1.SetID("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	i1.SetModified("2016-04-06T20:03:48.000Z")
+
+	i2 := i1.Clone()
+	i2.SetModified("2016-05-06T20:03:48.000Z")
+
+	r := report.New()
+
+	b1 := New()
+	b1.AddObject(r)
+	b1.AddObject(i2)
+	b1.AddObject(i1)
+
+	b2 := New()
+	b2.SetID(b1.ID)
+	b2.AddObject(i1)
+	b2.AddObject(r)
+	b2.AddObject(i2)
+
+	b1.Sort()
+	b2.Sort()
+
+	if b1.Objects[0] != i1 || b1.Objects[1] != i2 || b1.Objects[2] != r {
+		t.Error("Fail objects were not sorted by type, id, then modified")
+	}
+
+	d1, _ := b1.Encode()
+	d2, _ := b2.Encode()
+	if string(d1) != string(d2) {
+		t.Error("Fail sorted bundles should encode the same")
+	}
+}
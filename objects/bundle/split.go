@@ -0,0 +1,110 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ----------------------------------------------------------------------
+// Public Methods - Split
+// ----------------------------------------------------------------------
+
+/*
+SplitByType - This method will partition the objects in the bundle by their
+object type and return a new bundle for each type, keyed by the type. Each
+bundle gets a fresh id. Relationships and sightings are their own types, so
+they end up in their own bundles. The objects are shared with the original
+bundle, they are not copied.
+*/
+func (o *Bundle) SplitByType() map[string]*Bundle {
+	bundles := make(map[string]*Bundle)
+
+	for _, v := range o.Objects {
+		objType := v.GetCommonProperties().GetObjectType()
+		b, found := bundles[objType]
+		if !found {
+			b = o.newSplit()
+			bundles[objType] = b
+		}
+		b.AddObject(v)
+	}
+	return bundles
+}
+
+/*
+SplitBySize - This method will greedily pack the objects in the bundle, in
+order, into new bundles that each encode to no more than maxBytes of compact
+JSON, including the bundle type, id, and objects wrapper. Each bundle gets a
+fresh id. This is useful for servers that enforce a max_content_length. An
+error is returned if a single object does not fit in a bundle on its own.
+*/
+func (o *Bundle) SplitBySize(maxBytes int) ([]*Bundle, error) {
+	if maxBytes <= 0 {
+		return nil, fmt.Errorf("the max size must be greater than zero, got %d", maxBytes)
+	}
+
+	bundles := make([]*Bundle, 0)
+	var current *Bundle
+	size := 0
+
+	for _, v := range o.Objects {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		// Every object after the first in a bundle adds a comma
+		n := len(data)
+		if current != nil && len(current.Objects) > 0 {
+			n++
+		}
+
+		if current == nil || size+n > maxBytes {
+			current = o.newSplit()
+			size, err = current.overhead()
+			if err != nil {
+				return nil, err
+			}
+			n = len(data)
+			if size+n > maxBytes {
+				return nil, fmt.Errorf("%s is %d bytes and does not fit in a bundle of %d bytes", v.GetCommonProperties().ID, len(data), maxBytes)
+			}
+			bundles = append(bundles, current)
+		}
+
+		current.AddObject(v)
+		size += n
+	}
+	return bundles, nil
+}
+
+// ----------------------------------------------------------------------
+// Private Methods
+// ----------------------------------------------------------------------
+
+/*
+newSplit - This method will return a new empty bundle, with a fresh id, that
+carries over the spec_version of this bundle for STIX 2.0 bundles.
+*/
+func (o *Bundle) newSplit() *Bundle {
+	b := New()
+	b.SpecVersion = o.SpecVersion
+	return b
+}
+
+/*
+overhead - This method will return the number of bytes that the bundle takes
+up when it is encoded as compact JSON with an empty objects list.
+*/
+func (o *Bundle) overhead() (int, error) {
+	data, err := json.Marshal(o)
+	if err != nil {
+		return 0, err
+	}
+	return len(data) + len(`,"objects":[]`), nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+	"github.com/freetaxii/libstix2/objects/relationship"
+	"github.com/freetaxii/libstix2/objects/report"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestSplitByType - Make sure each object type ends up in its own bundle with a
+fresh bundle id.
+*/
+func TestSplitByType(t *testing.T) {
+	b := New()
+	b.AddObject(indicator.New())
+	b.AddObject(report.New())
+	b.AddObject(indicator.New())
+	b.AddObject(relationship.New())
+
+	split := b.SplitByType()
+	if len(split) != 3 {
+		t.Fatalf("Fail expected 3 bundles, got %d", len(split))
+	}
+
+	want := map[string]int{"indicator": 2, "report": 1, "relationship": 1}
+	for objType, count := range want {
+		sb, found := split[objType]
+		if !found {
+			t.Errorf("Fail missing bundle for %s", objType)
+			continue
+		}
+		if len(sb.Objects) != count {
+			t.Errorf("Fail expected %d %s objects, got %d", count, objType, len(sb.Objects))
+		}
+		if sb.ID == b.ID {
+			t.Errorf("Fail the %s bundle should have a fresh id", objType)
+		}
+	}
+}
+
+/*
+TestSplitBySize - Make sure each bundle encodes to no more than the size given
+and that all of the objects are kept in order.
+*/
+func TestSplitBySize(t *testing.T) {
+	b := New()
+	for i := 0; i < 10; i++ {
+		ind := indicator.New()
+		ind.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+		b.AddObject(ind)
+	}
+
+	const max = 1200
+	split, err := b.SplitBySize(max)
+	if err != nil {
+		t.Fatalf("Fail SplitBySize returned an error: %v", err)
+	}
+	if len(split) < 2 {
+		t.Fatalf("Fail expected more than 1 bundle, got %d", len(split))
+	}
+
+	i := 0
+	for _, sb := range split {
+		data, _ := json.Marshal(sb)
+		if len(data) > max {
+			t.Errorf("Fail bundle is %d bytes, more than %d", len(data), max)
+		}
+		for _, v := range sb.Objects {
+			if v.GetCommonProperties().ID != b.Objects[i].GetCommonProperties().ID {
+				t.Errorf("Fail object %d is out of order", i)
+			}
+			i++
+		}
+	}
+	if i != len(b.Objects) {
+		t.Errorf("Fail expected %d objects, got %d", len(b.Objects), i)
+	}
+
+	if _, err := b.SplitBySize(100); err == nil {
+		t.Error("Fail SplitBySize should fail when an object does not fit on its own")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"fmt"
+
+	"github.com/freetaxii/libstix2/objects/markingdefinition"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Marking Enforcement
+// ----------------------------------------------------------------------
+
+/*
+FilterByTLP - This function will return a new bundle, with a fresh id, that
+only has the objects from the bundle given whose object_marking_refs do not
+point at a TLP marking that is more restrictive than maxLevel, along with the
+ids of the objects that were removed. Both the canonical TLP marking
+definitions and TLP marking definitions found in the bundle are resolved.
+Objects without a TLP marking are kept, objects marked with a TLP level that
+is not known are removed. An error is returned if maxLevel is not a known TLP
+level.
+*/
+func FilterByTLP(b *Bundle, maxLevel string) (*Bundle, []string, error) {
+	maxRank, found := markingdefinition.TLPRank(maxLevel)
+	if !found {
+		return nil, nil, fmt.Errorf("the TLP level %q is not known", maxLevel)
+	}
+
+	// Find the TLP marking definitions that are included in the bundle
+	levels := make(map[string]string)
+	for _, v := range b.Objects {
+		if m, ok := v.(*markingdefinition.MarkingDefinition); ok {
+			if level := m.GetTLP(); level != "" {
+				levels[m.ID] = level
+			}
+		}
+	}
+
+	nb := b.newSplit()
+	removed := make([]string, 0)
+
+	for _, v := range b.Objects {
+		c := v.GetCommonProperties()
+		keep := true
+
+		for _, ref := range c.ObjectMarkingRefs {
+			level, found := markingdefinition.TLPLevelForID(ref)
+			if !found {
+				level, found = levels[ref]
+			}
+			if !found {
+				continue
+			}
+			if rank, known := markingdefinition.TLPRank(level); !known || rank > maxRank {
+				keep = false
+				break
+			}
+		}
+
+		if keep {
+			nb.AddObject(v)
+		} else {
+			removed = append(removed, c.ID)
+		}
+	}
+	return nb, removed, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+	"github.com/freetaxii/libstix2/objects/markingdefinition"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestFilterByTLP - Make sure objects marked above the allowed TLP level are
+removed, using both the canonical TLP markings and one found in the bundle.
+*/
+func TestFilterByTLP(t *testing.T) {
+	green := indicator.New()
+	green.ObjectMarkingRefs = []string{markingdefinition.TLPGreenID}
+
+	red := indicator.New()
+	red.ObjectMarkingRefs = []string{markingdefinition.TLPRedID}
+
+	md := markingdefinition.New()
+	md.SetName("TLP:AMBER+STRICT")
+	md.SetDefinitionType("tlp")
+	md.SetDefinition("tlp", "amber+strict")
+
+	strict := indicator.New()
+	strict.ObjectMarkingRefs = []string{md.ID}
+
+	unmarked := indicator.New()
+
+	b := New()
+	b.AddObject(md)
+	b.AddObject(green)
+	b.AddObject(red)
+	b.AddObject(strict)
+	b.AddObject(unmarked)
+
+	// Round trip the bundle to make sure decoded marking definitions resolve
+	data, _ := b.Encode()
+	b2, errs := Decode(bytes.NewReader(data))
+	if len(errs) > 0 {
+		t.Fatalf("Fail Decode returned errors: %v", errs)
+	}
+
+	nb, removed, err := FilterByTLP(b2, "amber")
+	if err != nil {
+		t.Fatalf("Fail FilterByTLP returned an error: %v", err)
+	}
+
+	if len(removed) != 2 || removed[0] != red.ID || removed[1] != strict.ID {
+		t.Errorf("Fail expected the red and amber+strict objects to be removed, got %v", removed)
+	}
+
+	if len(nb.Objects) != 3 {
+		t.Errorf("Fail expected 3 objects to be kept, got %d", len(nb.Objects))
+	}
+
+	if _, _, err := FilterByTLP(b2, "purple"); err == nil {
+		t.Error("Fail FilterByTLP should reject an unknown TLP level")
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// ----------------------------------------------------------------------
+// Public Methods
+// ----------------------------------------------------------------------
+
+/*
+SpecVersions - This method will return the distinct spec_version values of the
+objects in the bundle, sorted. Objects without a spec_version are STIX 2.0
+objects, as STIX 2.0 only carried it on the bundle, so they are reported as
+"2.0". The exception is SCOs, which STIX 2.1 allows to leave it out, so they
+are reported as "2.1".
+*/
+func (o *Bundle) SpecVersions() []string {
+	found := make(map[string]bool)
+	for _, v := range o.Objects {
+		found[objectSpecVersion(v.GetCommonProperties())] = true
+	}
+
+	versions := make([]string, 0, len(found))
+	for ver := range found {
+		versions = append(versions, ver)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+/*
+Valid - This method will verify and test all of the properties on the bundle
+to make sure they are valid per the specification. The type must be bundle,
+the id must be in the form bundle--uuid, and the spec_version must be present
+for a STIX 2.0 bundle and absent for a STIX 2.1 bundle. It will also check
+each object, see validObject(), and that the bundle does not mix STIX
+2.0 and STIX 2.1 objects, which is a sign of bundles that were merged without
+being converted. It will return a boolean, an integer that tracks the number
+of problems found, and a slice of strings that contain the detailed results,
+whether good or bad.
+*/
+func (o *Bundle) Valid(debug bool) (bool, int, []string) {
+	problemsFound := 0
+	resultDetails := make([]string, 0)
+
+	if o.ObjectType != "bundle" {
+		problemsFound++
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The type property must be bundle but is %q", o.ObjectType))
+	} else if debug {
+		resultDetails = append(resultDetails, "++ The type property is bundle")
+	}
+
+	if objType, _, err := objects.ParseID(o.ID); err != nil || objType != "bundle" {
+		problemsFound++
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The id property %q is not a valid bundle id", o.ID))
+	} else if debug {
+		resultDetails = append(resultDetails, "++ The id property is a valid bundle id")
+	}
+
+	for i, v := range o.Objects {
+		p, d := validObject(i, v, debug)
+		problemsFound += p
+		resultDetails = append(resultDetails, d...)
+	}
+
+	versions := o.SpecVersions()
+	if len(versions) > 1 {
+		problemsFound++
+		str := fmt.Sprintf("-- The bundle mixes objects of spec versions %s", strings.Join(versions, ", "))
+		resultDetails = append(resultDetails, str)
+	} else if debug && len(versions) == 1 {
+		resultDetails = append(resultDetails, fmt.Sprintf("++ All of the objects use spec version %s", versions[0]))
+	}
+
+	// STIX 2.0 bundles carry the spec_version, STIX 2.1 bundles must not
+	switch {
+	case o.SpecVersion != "" && o.SpecVersion != "2.0":
+		problemsFound++
+		str := fmt.Sprintf("-- The spec_version property on a bundle can only be 2.0, not %q, STIX 2.1 bundles do not have one", o.SpecVersion)
+		resultDetails = append(resultDetails, str)
+	case len(versions) == 1 && versions[0] == "2.0" && o.SpecVersion == "":
+		problemsFound++
+		resultDetails = append(resultDetails, "-- The spec_version property is required on a STIX 2.0 bundle but missing")
+	case len(versions) == 1 && versions[0] != "2.0" && o.SpecVersion != "":
+		problemsFound++
+		resultDetails = append(resultDetails, "-- The spec_version property must not be on a STIX 2.1 bundle")
+	case debug && len(versions) <= 1:
+		resultDetails = append(resultDetails, "++ The spec_version property of the bundle matches its objects")
+	}
+
+	if problemsFound > 0 {
+		return false, problemsFound, resultDetails
+	}
+	return true, 0, resultDetails
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+objectSpecVersion - This function will return the spec_version of an object,
+see SpecVersions() for the versions of objects that do not have one.
+*/
+func objectSpecVersion(c *objects.CommonObjectProperties) string {
+	switch {
+	case c.SpecVersion != "":
+		return c.SpecVersion
+	case objects.IsSCOType(c.ObjectType):
+		return "2.1"
+	}
+	return "2.0"
+}
+
+/*
+validator - This interface is implemented by the objects that have their own
+Valid() method.
+*/
+type validator interface {
+	Valid(debug bool) (bool, int, []string)
+}
+
+/*
+validObject - This function will check the object at index i of a bundle. Every
+object needs a type and an id of the form type--uuid. SCOs do not have the
+created and modified properties, so they are checked with their own Valid()
+method when they have one, as STIX 2.1 objects if they do not have a
+spec_version. All other objects need created, and all but marking
+definitions need modified. It will return the number of problems found and the
+detailed results, each one naming the object.
+*/
+func validObject(i int, v objects.STIXObject, debug bool) (int, []string) {
+	problemsFound := 0
+	resultDetails := make([]string, 0)
+	c := v.GetCommonProperties()
+
+	if c.ObjectType == "" || c.ID == "" {
+		problemsFound++
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The object at index %d is missing its type or id", i))
+		return problemsFound, resultDetails
+	}
+
+	if objType, _, err := objects.ParseID(c.ID); err != nil || objType != c.ObjectType {
+		problemsFound++
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The id %q is not a valid %s id", c.ID, c.ObjectType))
+	}
+
+	if objects.IsSCOType(c.ObjectType) {
+		// An SCO without a spec_version is a STIX 2.1 object, so check a copy
+		// that has it, as the Valid() methods of the SCOs require it
+		if c.SpecVersion == "" {
+			if cp, ok := objects.DeepCopy(v).(objects.STIXObject); ok {
+				cp.GetCommonProperties().SpecVersion = "2.1"
+				v = cp
+			}
+		}
+
+		if sco, ok := v.(validator); ok {
+			_, p, d := sco.Valid(debug)
+			problemsFound += p
+			for _, str := range d {
+				if len(str) > 3 {
+					str = str[:3] + c.ID + ": " + str[3:]
+				}
+				resultDetails = append(resultDetails, str)
+			}
+		}
+		return problemsFound, resultDetails
+	}
+
+	if c.Created == "" {
+		problemsFound++
+		resultDetails = append(resultDetails, fmt.Sprintf("-- %s: the created property is required but missing", c.ID))
+	}
+	if c.Modified == "" && c.ObjectType != "marking-definition" {
+		problemsFound++
+		resultDetails = append(resultDetails, fmt.Sprintf("-- %s: the modified property is required but missing", c.ID))
+	}
+	return problemsFound, resultDetails
+}
@@ -0,0 +1,183 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package bundle
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+	"github.com/freetaxii/libstix2/objects/sco/file"
+	"github.com/freetaxii/libstix2/objects/sco/ipv4addr"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestValidSpecVersions - Make sure a bundle of STIX 2.1 objects is valid and a
+bundle that mixes STIX 2.0 and STIX 2.1 objects is not.
+*/
+func TestValidSpecVersions(t *testing.T) {
+	b := New()
+	b.AddObject(indicator.New())
+	b.AddObject(indicator.New())
+
+	if got := b.SpecVersions(); len(got) != 1 || got[0] != "2.1" {
+		t.Errorf("Fail expected spec versions [2.1], got %v", got)
+	}
+
+	if valid, _, details := b.Valid(false); !valid {
+		t.Errorf("Fail bundle should be valid: %v", details)
+	}
+
+	old := indicator.New()
+	old.SetSpecVersion("")
+	b.AddObject(old)
+
+	if got := b.SpecVersions(); len(got) != 2 || got[0] != "2.0" || got[1] != "2.1" {
+		t.Errorf("Fail expected spec versions [2.0 2.1], got %v", got)
+	}
+
+	if valid, problems, _ := b.Valid(false); valid || problems != 1 {
+		t.Errorf("Fail bundle mixing spec versions should have 1 problem, got %d", problems)
+	}
+}
+
+/*
+TestValidEnvelope - Make sure the type, id, and spec_version of the bundle
+itself are checked.
+*/
+func TestValidEnvelope(t *testing.T) {
+	b := New()
+	b.AddObject(indicator.New())
+
+	b.SpecVersion = "2.0"
+	if valid, _, _ := b.Valid(false); valid {
+		t.Error("Fail a STIX 2.1 bundle should not have a spec_version")
+	}
+	b.SpecVersion = ""
+
+	old := New()
+	i := indicator.New()
+	i.SetSpecVersion("")
+	old.AddObject(i)
+	if valid, _, _ := old.Valid(false); valid {
+		t.Error("Fail a STIX 2.0 bundle should require a spec_version")
+	}
+	old.SetSpecVersion20()
+	if valid, _, details := old.Valid(false); !valid {
+		t.Errorf("Fail a STIX 2.0 bundle with a spec_version should be valid: %v", details)
+	}
+
+	b.SetID("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	b.SetObjectType("indicator")
+	if _, problems, _ := b.Valid(false); problems != 2 {
+		t.Errorf("Fail expected a bad type and id to be 2 problems, got %d", problems)
+	}
+}
+
+/*
+TestValidSCOs - Make sure SCOs in a bundle are not flagged for missing created
+and modified, but are still checked with their own Valid() method.
+*/
+func TestValidSCOs(t *testing.T) {
+	f := file.New()
+	f.SetName("evil.exe")
+	ip := ipv4addr.New()
+	ip.SetValue("198.51.100.3")
+
+	b := New()
+	b.AddObject(indicator.New())
+	b.AddObject(f)
+	b.AddObject(ip)
+
+	if valid, _, details := b.Valid(false); !valid {
+		t.Errorf("Fail a bundle with SCOs should be valid: %v", details)
+	}
+
+	b.AddObject(file.New())
+	if valid, problems, _ := b.Valid(false); valid || problems != 1 {
+		t.Errorf("Fail a file without a name or hashes should be 1 problem, got %d", problems)
+	}
+}
+
+/*
+TestValidObjectTimestamps - Make sure an SDO without created and modified is
+flagged in a bundle.
+*/
+func TestValidObjectTimestamps(t *testing.T) {
+	i := indicator.New()
+	i.Created = ""
+	i.Modified = ""
+
+	b := New()
+	b.AddObject(i)
+	if _, problems, _ := b.Valid(false); problems != 2 {
+		t.Errorf("Fail expected a missing created and modified to be 2 problems, got %d", problems)
+	}
+}
+
+/*
+TestValidSCOWithoutSpecVersion - Make sure an SCO without a spec_version is a
+STIX 2.1 object, so it does not make a STIX 2.1 bundle look mixed, and that the
+success line is not reported for a bundle that is mixed.
+*/
+func TestValidSCOWithoutSpecVersion(t *testing.T) {
+	ip := ipv4addr.New()
+	ip.SetValue("198.51.100.3")
+	ip.SpecVersion = ""
+
+	b := New()
+	b.AddObject(indicator.New())
+	b.AddObject(ip)
+
+	if got := b.SpecVersions(); len(got) != 1 || got[0] != "2.1" {
+		t.Errorf("Fail expected spec versions [2.1], got %v", got)
+	}
+
+	old := indicator.New()
+	old.SetSpecVersion("")
+	b.AddObject(old)
+
+	_, _, details := b.Valid(true)
+	for _, v := range details {
+		if strings.Contains(v, "matches its objects") {
+			t.Errorf("Fail a mixed bundle should not report that it matches its objects: %v", details)
+		}
+	}
+}
+
+/*
+TestValidSCOBundle - Make sure a bundle of SCOs without a spec_version, which
+STIX 2.1 allows, is valid.
+*/
+func TestValidSCOBundle(t *testing.T) {
+	f := file.New()
+	f.SetName("evil.exe")
+	f.SpecVersion = ""
+	ip := ipv4addr.New()
+	ip.SetValue("198.51.100.3")
+	ip.SpecVersion = ""
+
+	b := New()
+	b.AddObject(f)
+	b.AddObject(ip)
+
+	if valid, _, details := b.Valid(false); !valid {
+		t.Errorf("Fail a bundle of SCOs without a spec_version should be valid: %v", details)
+	}
+
+	b.AddObject(indicator.New())
+	if valid, _, details := b.Valid(false); !valid {
+		t.Errorf("Fail a STIX 2.1 bundle with SCOs without a spec_version should be valid: %v", details)
+	}
+
+	if f.SpecVersion != "" {
+		t.Error("Fail validating the bundle should not change the objects")
+	}
+}
@@ -23,6 +23,7 @@ type Campaign struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	objects.AliasesProperty
 	objects.SeenProperties
 	Objective string `json:"objective,omitempty" bson:"objective,omitempty"`
@@ -34,7 +35,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Campaign) GetPropertyList() []string {
-	return []string{"name", "description", "aliases", "first_seen", "last_seen", "objective"}
+	return []string{"name", "description", "x_descriptions", "aliases", "first_seen", "last_seen", "objective"}
 }
 
 // ----------------------------------------------------------------------
@@ -51,3 +52,12 @@ func New() *Campaign {
 	obj.InitSDO("campaign")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Campaign) Clone() *Campaign {
+	return objects.DeepCopy(o).(*Campaign)
+}
@@ -0,0 +1,244 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Canonical JSON
+// ----------------------------------------------------------------------
+
+// CanonicalJSON - This function will take in a STIX object and return it
+// encoded as canonical JSON following the JSON Canonicalization Scheme in RFC
+// 8785. Object keys are sorted, there is no insignificant whitespace, strings
+// use the minimal escaping, and numbers use the ECMAScript number format. The
+// same object will always produce the same bytes, regardless of field order or
+// map iteration order, so the result can be hashed or signed.
+func CanonicalJSON(obj STIXObject) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return CanonicalizeJSON(data)
+}
+
+// CanonicalizeJSON - This function will take in any JSON document and return
+// it in the canonical form described in CanonicalJSON().
+func CanonicalizeJSON(data []byte) ([]byte, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// versionProperties - These are the properties that EqualIgnoringVersion()
+// leaves out, as they change when a new version of an object is made.
+var versionProperties = []string{"created", "modified", "revoked"}
+
+// EqualIgnoringVersion - This function will return true if the two objects
+// have the same content, ignoring the created, modified, and revoked
+// properties. This tells whether a new version of an object actually changed
+// anything or just got a new timestamp. The objects are compared as canonical
+// JSON, so the order of map keys does not matter but the order of lists does.
+// Objects that can not be encoded are never equal.
+func EqualIgnoringVersion(a, b STIXObject) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	ca, err := canonicalJSONWithout(a, versionProperties)
+	if err != nil {
+		return false
+	}
+	cb, err := canonicalJSONWithout(b, versionProperties)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ca, cb)
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+// canonicalJSONWithout - This function will return the canonical JSON of the
+// object without the named top level properties.
+func canonicalJSONWithout(obj STIXObject, names []string) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		delete(m, name)
+	}
+
+	if data, err = json.Marshal(m); err != nil {
+		return nil, err
+	}
+	return CanonicalizeJSON(data)
+}
+
+func writeCanonical(buf *bytes.Buffer, v interface{}) error {
+	switch t := v.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(t))
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return err
+		}
+		s, err := canonicalNumber(f)
+		if err != nil {
+			return err
+		}
+		buf.WriteString(s)
+	case string:
+		writeCanonicalString(buf, t)
+	case []interface{}:
+		buf.WriteByte('[')
+		for i, e := range t {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeCanonical(buf, e); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		// RFC 8785 sorts keys by their UTF-16 code units
+		sort.Slice(keys, func(i, j int) bool {
+			a := utf16.Encode([]rune(keys[i]))
+			b := utf16.Encode([]rune(keys[j]))
+			for x := 0; x < len(a) && x < len(b); x++ {
+				if a[x] != b[x] {
+					return a[x] < b[x]
+				}
+			}
+			return len(a) < len(b)
+		})
+
+		buf.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			writeCanonicalString(buf, k)
+			buf.WriteByte(':')
+			if err := writeCanonical(buf, t[k]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value of type %T", v)
+	}
+	return nil
+}
+
+// writeCanonicalString - This function writes s as a JSON string only escaping
+// the characters that RFC 8785 requires to be escaped.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if r < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// canonicalNumber - This function formats f the same way ECMAScript converts a
+// number to a string, as required by RFC 8785.
+func canonicalNumber(f float64) (string, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return "", fmt.Errorf("the number %v can not be represented in JSON", f)
+	}
+	if f == 0 {
+		return "0", nil
+	}
+
+	sign := ""
+	if f < 0 {
+		sign = "-"
+		f = -f
+	}
+
+	// Get the shortest digits that round trip along with the exponent
+	e := strconv.FormatFloat(f, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(e, "e")
+	digits := strings.Replace(mantissa, ".", "", 1)
+	x, _ := strconv.Atoi(exp)
+	k := len(digits)
+	n := x + 1
+
+	switch {
+	case k <= n && n <= 21:
+		return sign + digits + strings.Repeat("0", n-k), nil
+	case 0 < n && n <= 21:
+		return sign + digits[:n] + "." + digits[n:], nil
+	case -6 < n && n <= 0:
+		return sign + "0." + strings.Repeat("0", -n) + digits, nil
+	}
+
+	expSign := "+"
+	if n-1 < 0 {
+		expSign = "-"
+	}
+	expValue := strconv.Itoa(int(math.Abs(float64(n - 1))))
+	if k == 1 {
+		return sign + digits + "e" + expSign + expValue, nil
+	}
+	return sign + digits[:1] + "." + digits[1:] + "e" + expSign + expValue, nil
+}
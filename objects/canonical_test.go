@@ -0,0 +1,90 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestCanonicalJSONFieldOrder - Make sure two logically equal objects that
+// were received with their properties in a different order produce the same
+// bytes.
+func TestCanonicalJSONFieldOrder(t *testing.T) {
+	data1 := `{"type": "x-test", "id": "x-test--6ba7b810-9dad-41d1-80b4-00c04fd430c8", "extensions": {"b": 2, "a": 1.50}, "labels": ["one", "two"]}`
+	data2 := `{"labels":["one","two"],"extensions":{"a":1.5,"b":2.0},"id":"x-test--6ba7b810-9dad-41d1-80b4-00c04fd430c8","type":"x-test"}`
+
+	var o1, o2 CommonObjectProperties
+	json.Unmarshal([]byte(data1), &o1)
+	json.Unmarshal([]byte(data2), &o2)
+
+	c1, err := CanonicalJSON(&o1)
+	if err != nil {
+		t.Fatalf("Fail unable to canonicalize object: %v", err)
+	}
+	c2, _ := CanonicalJSON(&o2)
+
+	if string(c1) != string(c2) {
+		t.Errorf("Fail canonical JSON does not match:\n%s\n%s", c1, c2)
+	}
+
+	want := `{"extensions":{"a":1.5,"b":2},"id":"x-test--6ba7b810-9dad-41d1-80b4-00c04fd430c8","labels":["one","two"],"type":"x-test"}`
+	if string(c1) != want {
+		t.Errorf("Fail canonical JSON is not correct:\n%s\n%s", c1, want)
+	}
+}
+
+// TestCanonicalNumbers - Make sure numbers follow the ECMAScript format.
+func TestCanonicalNumbers(t *testing.T) {
+	tests := map[string]string{
+		`1e21`:      "1e+21",
+		`1e20`:      "100000000000000000000",
+		`0.0000001`: "1e-7",
+		`0.000001`:  "0.000001",
+		`-12.50`:    "-12.5",
+		`1E3`:       "1000",
+		`"<&>"`:     `"<&>"`,
+	}
+
+	for in, want := range tests {
+		got, err := CanonicalizeJSON([]byte(in))
+		if err != nil {
+			t.Errorf("Fail unable to canonicalize %s: %v", in, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("Fail canonical form of %s: got %s want %s", in, got, want)
+		}
+	}
+}
+
+// TestEqualIgnoringVersion - Make sure a new version with only new timestamps
+// is equal and one with changed content is not.
+func TestEqualIgnoringVersion(t *testing.T) {
+	var o1 CommonObjectProperties
+	o1.InitSDO("x-test")
+	o1.SetCreated("2022-01-01T00:00:00.000Z")
+	o1.SetModified("2022-01-01T00:00:00.000Z")
+	o1.AddLabels("one")
+
+	o2 := o1
+	o2.Labels = []string{"one"}
+	o2.SetModified("2022-06-01T00:00:00.000Z")
+	o2.SetRevoked()
+
+	if !EqualIgnoringVersion(&o1, &o2) {
+		t.Error("Fail objects that only differ by version should be equal")
+	}
+
+	o2.AddLabels("two")
+	if EqualIgnoringVersion(&o1, &o2) {
+		t.Error("Fail objects with different labels should not be equal")
+	}
+
+	if EqualIgnoringVersion(&o1, nil) {
+		t.Error("Fail an object should not be equal to nil")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------
+// Define Variables
+// ----------------------------------------------------------------------
+
+var (
+	clockMu    sync.RWMutex
+	timeSource = time.Now
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Time Source
+// ----------------------------------------------------------------------
+
+// SetTimeSource - This function will set the clock that is used everywhere a
+// timestamp is generated, like the created and modified properties of new
+// objects. The default is time.Now, passing in nil restores it. Tests can use
+// a fixed clock so that the timestamps are known.
+func SetTimeSource(f func() time.Time) {
+	if f == nil {
+		f = time.Now
+	}
+
+	clockMu.Lock()
+	defer clockMu.Unlock()
+	timeSource = f
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+// now - This function will return the current time from the time source.
+func now() time.Time {
+	clockMu.RLock()
+	defer clockMu.RUnlock()
+	return timeSource()
+}
@@ -0,0 +1,51 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"testing"
+	"time"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestSetTimeSource - Make sure the timestamps come from the time source and
+that passing in nil restores the real clock.
+*/
+func TestSetTimeSource(t *testing.T) {
+	fixed := time.Date(2022, 3, 4, 5, 6, 7, 123456000, time.UTC)
+	SetTimeSource(func() time.Time { return fixed })
+	defer SetTimeSource(nil)
+
+	if got := GetCurrentTime("micro"); got != "2022-03-04T05:06:07.123456Z" {
+		t.Errorf("Fail expected the fixed time, got %s", got)
+	}
+
+	var o CommonObjectProperties
+	o.SetCreatedToCurrentTime()
+	o.SetModifiedToCurrentTime()
+	if o.Created != "2022-03-04T05:06:07.123Z" || o.Modified != o.Created {
+		t.Errorf("Fail expected created and modified to be the fixed time, got %s and %s", o.Created, o.Modified)
+	}
+
+	o.Touch()
+	if o.Modified != "2022-03-04T05:06:07.123456Z" {
+		t.Errorf("Fail expected touch to use the fixed time, got %s", o.Modified)
+	}
+
+	o.Touch()
+	if o.Modified != "2022-03-04T05:06:07.123457Z" {
+		t.Errorf("Fail expected touch to move modified by a microsecond, got %s", o.Modified)
+	}
+
+	SetTimeSource(nil)
+	if got := GetCurrentTime("micro"); got == "2022-03-04T05:06:07.123456Z" {
+		t.Error("Fail expected nil to restore the real clock")
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"reflect"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Copying
+// ----------------------------------------------------------------------
+
+/*
+DeepCopy - This function will return a deep copy of v. Every slice, map and
+pointer that is reachable from v through exported fields is copied, so the copy
+can be changed without changing v. Unexported fields are copied as they are.
+This is used by the Clone() method on each object, and the result has the same
+type as v.
+*/
+func DeepCopy(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	return deepCopyValue(reflect.ValueOf(v)).Interface()
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - CommonObjectProperties
+// ----------------------------------------------------------------------
+
+/*
+Clone - This method will return a deep copy of the common properties, including
+the labels, external references, markings, extensions and custom properties.
+*/
+func (o *CommonObjectProperties) Clone() *CommonObjectProperties {
+	return DeepCopy(o).(*CommonObjectProperties)
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+deepCopyValue - This function will walk the value and return a copy of it that
+does not share any slices, maps or pointers with the original.
+*/
+func deepCopyValue(src reflect.Value) reflect.Value {
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return reflect.Zero(src.Type())
+		}
+		dst := reflect.New(src.Elem().Type())
+		dst.Elem().Set(deepCopyValue(src.Elem()))
+		return dst
+
+	case reflect.Interface:
+		if src.IsNil() {
+			return reflect.Zero(src.Type())
+		}
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(deepCopyValue(src.Elem()))
+		return dst
+
+	case reflect.Struct:
+		dst := reflect.New(src.Type()).Elem()
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if dst.Field(i).CanSet() {
+				dst.Field(i).Set(deepCopyValue(src.Field(i)))
+			}
+		}
+		return dst
+
+	case reflect.Slice:
+		if src.IsNil() {
+			return reflect.Zero(src.Type())
+		}
+		dst := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepCopyValue(src.Index(i)))
+		}
+		return dst
+
+	case reflect.Array:
+		dst := reflect.New(src.Type()).Elem()
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepCopyValue(src.Index(i)))
+		}
+		return dst
+
+	case reflect.Map:
+		if src.IsNil() {
+			return reflect.Zero(src.Type())
+		}
+		dst := reflect.MakeMapWithSize(src.Type(), src.Len())
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+		return dst
+	}
+
+	return src
+}
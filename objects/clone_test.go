@@ -0,0 +1,48 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"testing"
+)
+
+// TestClone - Make sure changing the slices and maps of a clone does not
+// change the original.
+func TestClone(t *testing.T) {
+	o := &CommonObjectProperties{
+		ID:     "indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f",
+		Labels: []string{"a"},
+		ExternalReferences: []ExternalReference{
+			{SourceName: "x", Hashes: map[string]string{"MD5": "1"}},
+		},
+		Extensions: map[string]interface{}{
+			"ext": map[string]interface{}{"list": []interface{}{"v"}},
+		},
+		Custom: map[string][]byte{"x_foo": []byte(`"bar"`)},
+	}
+
+	c := o.Clone()
+	c.Labels[0] = "b"
+	c.ExternalReferences[0].Hashes["MD5"] = "2"
+	c.Extensions["ext"].(map[string]interface{})["list"].([]interface{})[0] = "w"
+	c.Custom["x_foo"][1] = 'B'
+
+	if o.Labels[0] != "a" {
+		t.Error("Fail labels are shared with the clone")
+	}
+	if o.ExternalReferences[0].Hashes["MD5"] != "1" {
+		t.Error("Fail external reference hashes are shared with the clone")
+	}
+	if o.Extensions["ext"].(map[string]interface{})["list"].([]interface{})[0] != "v" {
+		t.Error("Fail extensions are shared with the clone")
+	}
+	if string(o.Custom["x_foo"]) != `"bar"` {
+		t.Error("Fail custom properties are shared with the clone")
+	}
+	if c.ID != o.ID {
+		t.Error("Fail id was not copied")
+	}
+}
@@ -23,6 +23,7 @@ type CourseOfAction struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 }
 
 // TODO Finish fleshing out this model to 2.1
@@ -33,7 +34,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *CourseOfAction) GetPropertyList() []string {
-	return []string{"name", "description"}
+	return []string{"name", "description", "x_descriptions"}
 }
 
 // ----------------------------------------------------------------------
@@ -49,3 +50,12 @@ func New() *CourseOfAction {
 	obj.InitSDO("course-of-action")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *CourseOfAction) Clone() *CourseOfAction {
+	return objects.DeepCopy(o).(*CourseOfAction)
+}
@@ -0,0 +1,122 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"encoding/json"
+
+	"github.com/freetaxii/libstix2/defs"
+)
+
+// ----------------------------------------------------------------------
+// Define Object Model
+// ----------------------------------------------------------------------
+
+// CustomObject - This type is used for STIX objects that this library does not
+// have a type for, like custom objects or objects from a newer version of the
+// specification. The common properties are decoded as usual, and every other
+// property is kept in the Properties map as raw JSON, so the object can be
+// encoded again without losing anything.
+type CustomObject struct {
+	CommonObjectProperties
+	Properties map[string]json.RawMessage `json:"-" bson:"-"`
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - JSON Decoder
+// ----------------------------------------------------------------------
+
+// DecodeCustom - This function will decode a slice of bytes into a
+// CustomObject and return a pointer to it along with any errors. This is called
+// from the Bundle Decode() if the object type is not one that this library
+// knows about.
+func DecodeCustom(data []byte) (*CustomObject, error) {
+	var o CustomObject
+
+	if err := json.Unmarshal(data, &o); err != nil {
+		return nil, err
+	}
+
+	return &o, nil
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - CustomObject
+// ----------------------------------------------------------------------
+
+// GetProperty - This method will return the raw JSON value of a property that
+// is not one of the common properties, and true if the object has it.
+func (o *CustomObject) GetProperty(name string) (json.RawMessage, bool) {
+	v, found := o.Properties[name]
+	return v, found
+}
+
+// SetProperty - This method will encode the value as JSON and store it as a
+// property of the object. This can not be used to set a common property.
+func (o *CustomObject) SetProperty(name string, value interface{}) error {
+	data, err := EncodeWithOptions(value, MarshalOptions{})
+	if err != nil {
+		return err
+	}
+
+	if o.Properties == nil {
+		o.Properties = make(map[string]json.RawMessage)
+	}
+	o.Properties[name] = data
+	return nil
+}
+
+// UnmarshalJSON - This method will decode the common properties and keep all
+// of the other properties in the Properties map.
+func (o *CustomObject) UnmarshalJSON(b []byte) error {
+	if err := json.Unmarshal(b, &o.CommonObjectProperties); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(b, &all); err != nil {
+		return err
+	}
+
+	for _, v := range o.GetCommonPropertyList() {
+		delete(all, v)
+	}
+
+	o.Properties = nil
+	if len(all) > 0 {
+		o.Properties = all
+	}
+
+	if defs.KEEP_RAW_DATA == true {
+		o.SetRawData(b)
+	}
+
+	return nil
+}
+
+// MarshalJSON - This method will encode the common properties and all of the
+// other properties together as a single JSON object. HTML characters are not
+// escaped here, the encoder that calls this method will escape them if it was
+// asked to.
+func (o *CustomObject) MarshalJSON() ([]byte, error) {
+	data, err := EncodeWithOptions(&o.CommonObjectProperties, MarshalOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	for k, v := range o.Properties {
+		if _, found := all[k]; !found {
+			all[k] = v
+		}
+	}
+
+	return EncodeWithOptions(all, MarshalOptions{})
+}
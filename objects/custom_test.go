@@ -0,0 +1,79 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// TestCustomObjectRoundTrip - Make sure a custom object can be decoded and
+// encoded again without losing any properties.
+func TestCustomObjectRoundTrip(t *testing.T) {
+	data := `{"type":"x-acme-widget","spec_version":"2.1","id":"x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b","created":"2016-04-06T20:03:48.000Z","modified":"2016-04-06T20:03:48.000Z","name":"Widget","parts":[{"n":1},{"n":2}],"x_score":9.5}`
+
+	o, err := DecodeCustom([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if o.GetObjectType() != "x-acme-widget" || o.GetID() != "x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b" {
+		t.Error("Fail common properties were not decoded")
+	}
+
+	if v, found := o.GetProperty("name"); !found || string(v) != `"Widget"` {
+		t.Error("Fail name was not kept as a property")
+	}
+
+	if _, found := o.GetProperty("type"); found {
+		t.Error("Fail common properties should not be in the properties map")
+	}
+
+	out, err := json.Marshal(o)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want, got map[string]interface{}
+	json.Unmarshal([]byte(data), &want)
+	json.Unmarshal(out, &got)
+	w, _ := json.Marshal(want)
+	g, _ := json.Marshal(got)
+	if string(w) != string(g) {
+		t.Errorf("Fail round trip is not lossless\n got: %s\nwant: %s", g, w)
+	}
+}
+
+// TestCustomObjectEscapeHTML - Make sure HTML characters in a custom object are
+// only escaped when the options ask for it.
+func TestCustomObjectEscapeHTML(t *testing.T) {
+	data := `{"type":"x-acme-widget","spec_version":"2.1","id":"x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b","created":"2016-04-06T20:03:48.000Z","modified":"2016-04-06T20:03:48.000Z","rule":"a < b & c"}`
+
+	o, err := DecodeCustom([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	o.SetProperty("x_note", "d > e")
+
+	out, err := EncodeWithOptions(o, MarshalOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{`"rule":"a < b & c"`, `"x_note":"d > e"`} {
+		if !strings.Contains(string(out), v) {
+			t.Errorf("Fail expected %s in %s", v, out)
+		}
+	}
+
+	out, err = EncodeWithOptions(o, MarshalOptions{EscapeHTML: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), `"rule":"a \u003c b \u0026 c"`) {
+		t.Errorf("Fail expected the value to be escaped in %s", out)
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import "strings"
+
+// ----------------------------------------------------------------------
+// Public Functions - Defanging
+// ----------------------------------------------------------------------
+
+// These are the substitutions that are used to defang values. Refang() will
+// undo every one of them.
+var refangReplacer = strings.NewReplacer(
+	"[.]", ".",
+	"(.)", ".",
+	"[@]", "@",
+	"[:]", ":",
+	"[://]", "://",
+)
+
+// DefangDomain - This function takes in a domain name or an IPv4 address and
+// returns the defanged version of it, where each "." is replaced with "[.]".
+// For example "example.com" becomes "example[.]com".
+func DefangDomain(s string) string {
+	s = Refang(s)
+	return strings.ReplaceAll(s, ".", "[.]")
+}
+
+// DefangEmailAddress - This function takes in an email address and returns the
+// defanged version of it. For example "jdoe@example.com" becomes
+// "jdoe[@]example[.]com".
+func DefangEmailAddress(s string) string {
+	s = Refang(s)
+	i := strings.LastIndex(s, "@")
+	if i == -1 {
+		return DefangDomain(s)
+	}
+	return s[:i] + "[@]" + DefangDomain(s[i+1:])
+}
+
+// DefangURL - This function takes in a URL and returns the defanged version of
+// it. The http, https, and ftp schemes are rewritten to hxxp, hxxps, and fxp,
+// and the host part of the URL is defanged like a domain name. For example
+// "http://example.com/index.html" becomes "hxxp://example[.]com/index.html".
+// Only the scheme and host are refanged before they are defanged again, so a
+// "[.]" or "(.)" in the path or query of a URL is left as it is.
+func DefangURL(s string) string {
+	s = RefangURL(s)
+
+	scheme := ""
+	rest := s
+	if i := strings.Index(s, "://"); i != -1 {
+		scheme = s[:i]
+		rest = s[i+3:]
+
+		switch strings.ToLower(scheme) {
+		case "http", "https":
+			scheme = "hxxp" + scheme[4:]
+		case "ftp":
+			scheme = "fxp"
+		}
+		scheme += "://"
+	}
+
+	host := rest
+	path := ""
+	if i := strings.IndexAny(rest, "/?#"); i != -1 {
+		host = rest[:i]
+		path = rest[i:]
+	}
+
+	return scheme + DefangDomain(host) + path
+}
+
+// RefangURL - This function takes in a defanged URL and returns the original
+// URL. Only the scheme and host are refanged, which are the only parts that
+// DefangURL changes, so a "[.]" or "(.)" in the path or query is kept.
+func RefangURL(s string) string {
+	end := urlHostEnd(s)
+	return Refang(s[:end]) + s[end:]
+}
+
+// Refang - This function takes in a defanged value and returns the original
+// value by undoing all of the substitutions made by the Defang functions. Every
+// substitution in the value is undone, so use RefangURL() for a URL that can
+// have "[.]" or "(.)" in its path or query.
+func Refang(s string) string {
+	s = refangReplacer.Replace(s)
+
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasPrefix(lower, "hxxp"):
+		s = "http" + s[4:]
+	case strings.HasPrefix(lower, "fxp://"):
+		s = "ftp" + s[3:]
+	}
+	return s
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+// urlHostEnd - This function returns the index in the URL where the scheme and
+// host end and the path, query, or fragment begins. The scheme separator can
+// be defanged as "[://]".
+func urlHostEnd(s string) int {
+	start := 0
+	if i := strings.Index(s, "://"); i != -1 {
+		start = i + 3
+		if strings.HasPrefix(s[start:], "]") {
+			start++
+		}
+	}
+	if i := strings.IndexAny(s[start:], "/?#"); i != -1 {
+		return start + i
+	}
+	return len(s)
+}
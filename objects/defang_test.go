@@ -0,0 +1,58 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import "testing"
+
+// TestDefangURL - Make sure the scheme and host are defanged, already defanged
+// URLs are not defanged twice, and the path and query are left alone.
+func TestDefangURL(t *testing.T) {
+	tests := map[string]string{
+		"http://example.com/index.html":    "hxxp://example[.]com/index.html",
+		"https://example.com":              "hxxps://example[.]com",
+		"ftp://example.com/file.txt":       "fxp://example[.]com/file.txt",
+		"hxxp://example[.]com/index.html":  "hxxp://example[.]com/index.html",
+		"hxxp[://]example[.]com/a":         "hxxp://example[.]com/a",
+		"http://example.com/a[.]b?q=(.)":   "hxxp://example[.]com/a[.]b?q=(.)",
+		"hxxp://example[.]com/a[.]b?q=[@]": "hxxp://example[.]com/a[.]b?q=[@]",
+		"example.com/hxxp[.]html":          "example[.]com/hxxp[.]html",
+	}
+
+	for in, want := range tests {
+		if got := DefangURL(in); got != want {
+			t.Errorf("Fail %q gave %q, expected %q", in, got, want)
+		}
+	}
+}
+
+// TestRefang - Make sure Refang undoes DefangURL for a URL without defanged
+// text in its path.
+func TestRefang(t *testing.T) {
+	in := "https://www.example.com/index.html?a=b"
+	if got := Refang(DefangURL(in)); got != in {
+		t.Errorf("Fail expected %q, got %q", in, got)
+	}
+}
+
+// TestRefangURL - Make sure only the scheme and host of a URL are refanged.
+func TestRefangURL(t *testing.T) {
+	tests := map[string]string{
+		"hxxp://example[.]com/index.html":   "http://example.com/index.html",
+		"hxxps[://]www[.]example[.]com":     "https://www.example.com",
+		"fxp://example[.]com/a[.]b?q=x(.)y": "ftp://example.com/a[.]b?q=x(.)y",
+	}
+
+	for in, want := range tests {
+		if got := RefangURL(in); got != want {
+			t.Errorf("Fail %q gave %q, expected %q", in, got, want)
+		}
+	}
+
+	in := "http://example.com/a[.]b?q=x(.)y"
+	if got := RefangURL(DefangURL(in)); got != in {
+		t.Errorf("Fail round trip expected %q, got %q", in, got)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 
 	"github.com/freetaxii/libstix2/defs"
+	"github.com/freetaxii/libstix2/objects"
 )
 
 // ----------------------------------------------------------------------
@@ -98,3 +99,11 @@ func (o *Grouping) EncodeToString() (string, error) {
 	}
 	return string(data), nil
 }
+
+/*
+EncodeWithOptions - This method will encode the object into JSON using the
+options given, see objects.MarshalOptions.
+*/
+func (o *Grouping) EncodeWithOptions(opts objects.MarshalOptions) ([]byte, error) {
+	return objects.EncodeWithOptions(o, opts)
+}
@@ -23,6 +23,7 @@ type Grouping struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	Context string `json:"context,omitempty" bson:"context,omitempty"`
 	objects.ObjectRefsProperty
 }
@@ -33,7 +34,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Grouping) GetPropertyList() []string {
-	return []string{"name", "description", "context", "object_refs"}
+	return []string{"name", "description", "x_descriptions", "context", "object_refs"}
 }
 
 // ----------------------------------------------------------------------
@@ -50,3 +51,12 @@ func New() *Grouping {
 	obj.InitSDO("grouping")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Grouping) Clone() *Grouping {
+	return objects.DeepCopy(o).(*Grouping)
+}
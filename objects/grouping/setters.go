@@ -4,3 +4,66 @@
 // found in the LICENSE file in the root of the source tree.
 
 package grouping
+
+import (
+	"errors"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// ----------------------------------------------------------------------
+// Public Methods
+// ----------------------------------------------------------------------
+
+/*
+SetContext - This method takes in a string value representing the context of
+the grouping and updates the context property. The value should come from the
+grouping-context-ov vocabulary, see vocabs.GetGroupingVocab(), but as this is an
+open vocabulary other values are allowed.
+*/
+func (o *Grouping) SetContext(s string) error {
+	if s == "" {
+		return errors.New("the context property can not be empty")
+	}
+	o.Context = s
+	return nil
+}
+
+/*
+GetContext - This method will return the context property.
+*/
+func (o *Grouping) GetContext() string {
+	return o.Context
+}
+
+/*
+AddObjectRef - This method takes in a STIX identifier and adds it to the object
+refs property. The identifier must be in the form type--uuid, and one that is
+already in the grouping is not added again, so adding the same reference twice
+is not an error.
+*/
+func (o *Grouping) AddObjectRef(s string) error {
+	if _, _, err := objects.ParseID(s); err != nil {
+		return err
+	}
+
+	if !o.Contains(s) {
+		o.ObjectRefs = append(o.ObjectRefs, s)
+	}
+	return nil
+}
+
+/*
+RemoveObjectRef - This method takes in a STIX identifier and removes it from
+the object refs property. It will return an error if the grouping does not
+reference it.
+*/
+func (o *Grouping) RemoveObjectRef(s string) error {
+	for i, v := range o.ObjectRefs {
+		if v == s {
+			o.ObjectRefs = append(o.ObjectRefs[:i], o.ObjectRefs[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("the object ref " + s + " is not in the grouping")
+}
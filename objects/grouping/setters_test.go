@@ -0,0 +1,75 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package grouping
+
+import "testing"
+
+// TestSetContext -
+func TestSetContext(t *testing.T) {
+	g := New()
+	want := "suspicious-activity"
+	g.SetContext(want)
+
+	if got := g.GetContext(); got != want {
+		t.Error("Fail Grouping Set Context Check")
+	}
+
+	if err := g.SetContext(""); err == nil {
+		t.Error("Fail Grouping Set Context should reject an empty value")
+	}
+}
+
+// TestAddObjectRef -
+func TestAddObjectRef(t *testing.T) {
+	g := New()
+	g.SetContext("suspicious-activity")
+	g.AddObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	g.AddObjectRef("malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b")
+
+	if got := g.GetObjectRefs(); len(got) != 2 || got[1] != "malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b" {
+		t.Error("Fail Grouping Add Object Ref Check")
+	}
+
+	if valid, _, details := g.Valid(false); !valid {
+		t.Error("Fail Grouping built with the helpers should be valid")
+		t.Log(details)
+	}
+}
+
+// TestAddObjectRefDuplicates -
+func TestAddObjectRefDuplicates(t *testing.T) {
+	g := New()
+	g.SetContext("suspicious-activity")
+	g.AddObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	g.AddObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+
+	if got := g.GetObjectRefs(); len(got) != 1 {
+		t.Errorf("Fail Grouping Add Object Ref should skip duplicates, got %v", got)
+	}
+
+	if err := g.AddObjectRef("not-an-id"); err == nil {
+		t.Error("Fail Grouping Add Object Ref should reject a bad identifier")
+	}
+}
+
+// TestRemoveObjectRef -
+func TestRemoveObjectRef(t *testing.T) {
+	g := New()
+	g.SetContext("suspicious-activity")
+	g.AddObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+
+	if err := g.RemoveObjectRef("malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b"); err == nil {
+		t.Error("Fail Grouping Remove Object Ref should reject a missing ref")
+	}
+
+	if err := g.RemoveObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f"); err != nil {
+		t.Error("Fail Grouping Remove Object Ref Check")
+	}
+
+	if valid, _, _ := g.Valid(false); valid {
+		t.Error("Fail Grouping without object refs should not be valid")
+	}
+}
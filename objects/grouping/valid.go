@@ -26,6 +26,11 @@ func (o *Grouping) Valid(debug bool) (bool, int, []string) {
 	problemsFound += pBase
 	resultDetails = append(resultDetails, dBase...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	if o.Context == "" {
 		problemsFound++
 		str := fmt.Sprintf("-- The context property is required but missing")
@@ -35,10 +40,10 @@ func (o *Grouping) Valid(debug bool) (bool, int, []string) {
 		resultDetails = append(resultDetails, str)
 	}
 
-	// Verify object refs property is present
-	// _, pObjectRefs, dObjectRefs := o.ObjectRefsProperty.VerifyExists()
-	// problemsFound += pObjectRefs
-	// resultDetails = append(resultDetails, dObjectRefs...)
+	// Verify object refs property is present and each ref is well formed
+	_, pObjectRefs, dObjectRefs := o.ObjectRefsProperty.Valid(debug)
+	problemsFound += pObjectRefs
+	resultDetails = append(resultDetails, dObjectRefs...)
 
 	if problemsFound > 0 {
 		return false, problemsFound, resultDetails
@@ -0,0 +1,90 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// hashAlgorithmNames - This maps the upper case form of a hash algorithm name,
+// with all dashes and underscores removed, to the name used by the STIX
+// hashing-algorithm-ov vocabulary. This allows "sha256", "SHA256", and
+// "sha-256" to all be recorded as "SHA-256".
+var hashAlgorithmNames = map[string]string{
+	"MD5":     "MD5",
+	"SHA1":    "SHA-1",
+	"SHA256":  "SHA-256",
+	"SHA512":  "SHA-512",
+	"SHA3256": "SHA3-256",
+	"SHA3512": "SHA3-512",
+	"SSDEEP":  "SSDEEP",
+	"TLSH":    "TLSH",
+}
+
+// hashDigestLengths - This records the length in hex characters of the digest
+// for each hashing algorithm that produces a fixed length hex digest.
+var hashDigestLengths = map[string]int{
+	"MD5":      32,
+	"SHA-1":    40,
+	"SHA-256":  64,
+	"SHA-512":  128,
+	"SHA3-256": 64,
+	"SHA3-512": 128,
+}
+
+var hexDigest = regexp.MustCompile(`^[a-f0-9]+$`)
+
+// ----------------------------------------------------------------------
+// Public Functions - Hashes
+// ----------------------------------------------------------------------
+
+// NormalizeHashAlgorithm - This function takes in the name of a hashing
+// algorithm and returns the name used by the STIX hashing-algorithm-ov
+// vocabulary. Custom algorithm names that start with "x_" are returned as is,
+// all other unknown names return an error.
+func NormalizeHashAlgorithm(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	if strings.HasPrefix(s, "x_") {
+		return s, nil
+	}
+
+	key := strings.ToUpper(strings.NewReplacer("-", "", "_", "").Replace(s))
+	if name, found := hashAlgorithmNames[key]; found {
+		return name, nil
+	}
+	return "", fmt.Errorf("the hashing algorithm \"%s\" is not in the hashing-algorithm-ov vocabulary and does not start with x_", s)
+}
+
+// NormalizeHash - This function takes in a hashing algorithm name and a digest
+// and returns the normalized algorithm name and digest. Hex digests are
+// trimmed and lower cased, other digests like SSDEEP are case sensitive and are
+// only trimmed.
+func NormalizeHash(algorithm, digest string) (string, string, error) {
+	name, err := NormalizeHashAlgorithm(algorithm)
+	if err != nil {
+		return "", "", err
+	}
+
+	digest = strings.TrimSpace(digest)
+	if _, found := hashDigestLengths[name]; found {
+		digest = strings.ToLower(digest)
+	}
+	return name, digest, nil
+}
+
+// IsHashDigestValid - This function takes in a normalized hashing algorithm
+// name and a digest and returns true if the digest is a hex string of the
+// right length for that algorithm. Algorithms without a fixed length hex
+// digest only need a value to be present.
+func IsHashDigestValid(algorithm, digest string) bool {
+	length, found := hashDigestLengths[algorithm]
+	if !found {
+		return digest != ""
+	}
+	return len(digest) == length && hexDigest.MatchString(digest)
+}
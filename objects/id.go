@@ -0,0 +1,113 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Identifiers
+// ----------------------------------------------------------------------
+
+// ParseID - This function will take in a STIX identifier in the form
+// type--uuid and return the object type and the UUID. It will return an error
+// if the identifier is not in that form, if the type is not one that this
+// library knows about and is not a custom type starting with x- or x_, or if
+// the UUID is not a valid RFC 4122 UUID in its canonical form.
+func ParseID(id string) (string, string, error) {
+	idparts := strings.Split(id, "--")
+	if len(idparts) != 2 {
+		return "", "", fmt.Errorf("the identifier %q is not in the form type--uuid", id)
+	}
+	stixType, stixUUID := idparts[0], idparts[1]
+
+	if !ValidObjectType(stixType) && !isCustomObjectType(stixType) {
+		return "", "", fmt.Errorf("the identifier %q has an unknown object type %q", id, stixType)
+	}
+
+	u, err := uuid.Parse(stixUUID)
+	if err != nil || len(stixUUID) != 36 || u.Variant() != uuid.RFC4122 {
+		return "", "", fmt.Errorf("the identifier %q does not contain a valid uuid", id)
+	}
+
+	return stixType, stixUUID, nil
+}
+
+// ValidID - This function will return true if the STIX identifier can be
+// parsed by ParseID().
+func ValidID(id string) bool {
+	_, _, err := ParseID(id)
+	return err == nil
+}
+
+// IsExtensionNameValid - This function will return true if the name can be
+// used as a key in the extensions property. That is the identifier of an
+// extension-definition object, one of the extensions predefined by the STIX
+// 2.1 specification, or a custom extension name that starts with x-, which
+// STIX 2.1 still allows but has deprecated.
+func IsExtensionNameValid(s string) bool {
+	if predefinedExtensions[s] {
+		return true
+	}
+
+	if strings.HasPrefix(s, "x-") {
+		return isCustomObjectType(s)
+	}
+
+	stixType, _, err := ParseID(s)
+	return err == nil && stixType == "extension-definition"
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+// isCustomObjectType - This function will return true if the object type is a
+// custom object type. STIX requires custom types to start with x-, and some
+// producers use x_, so both are accepted. The rest of the type may only use
+// lower case ASCII letters, digits and hyphens.
+func isCustomObjectType(s string) bool {
+	if !strings.HasPrefix(s, "x-") && !strings.HasPrefix(s, "x_") {
+		return false
+	}
+
+	rest := s[2:]
+	if rest == "" || strings.HasPrefix(rest, "-") || strings.HasSuffix(rest, "-") {
+		return false
+	}
+
+	for _, c := range rest {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// ----------------------------------------------------------------------
+// Private Variables
+// ----------------------------------------------------------------------
+
+// predefinedExtensions - These are the extension names that are defined by the
+// STIX 2.1 specification for the cyber observable objects.
+var predefinedExtensions = map[string]bool{
+	"archive-ext":          true,
+	"ntfs-ext":             true,
+	"pdf-ext":              true,
+	"raster-image-ext":     true,
+	"windows-pebinary-ext": true,
+	"http-request-ext":     true,
+	"icmp-ext":             true,
+	"socket-ext":           true,
+	"tcp-ext":              true,
+	"windows-process-ext":  true,
+	"windows-service-ext":  true,
+	"unix-account-ext":     true,
+}
@@ -0,0 +1,62 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import "testing"
+
+// TestParseID - Make sure identifiers are split in to their type and UUID and
+// that malformed identifiers are rejected instead of causing a panic.
+func TestParseID(t *testing.T) {
+	stixType, stixUUID, err := ParseID("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	if err != nil || stixType != "indicator" || stixUUID != "8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f" {
+		t.Errorf("Fail ParseID returned %q %q %v", stixType, stixUUID, err)
+	}
+
+	valid := []string{
+		"x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b",
+		"x_acme--31b940d4-6f7f-459a-80ea-9c1f17b5891b",
+		"file--fb0419a8-f09c-57f8-be64-71a80417591c",
+	}
+	for _, v := range valid {
+		if !ValidID(v) {
+			t.Errorf("Fail %q should be valid", v)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"indicator",
+		"indicator--",
+		"widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b",
+		"x---31b940d4-6f7f-459a-80ea-9c1f17b5891b",
+		"indicator--31b940d46f7f459a80ea9c1f17b5891b",
+		"indicator--31b940d4-6f7f-459a-80ea-9c1f17b5891b--x",
+	}
+	for _, v := range invalid {
+		if ValidID(v) || IsIDValid(v) {
+			t.Errorf("Fail %q should be invalid", v)
+		}
+	}
+}
+
+// TestInitSDOID - Make sure InitSDO creates an id that passes ParseID and
+// rejects a type that ParseID does not accept.
+func TestInitSDOID(t *testing.T) {
+	var o CommonObjectProperties
+	if err := o.InitSDO("indicator"); err != nil || !ValidID(o.ID) {
+		t.Errorf("Fail InitSDO should create a valid id, got %q: %v", o.ID, err)
+	}
+
+	var custom CommonObjectProperties
+	if err := custom.InitSDO("x-acme-widget"); err != nil || !ValidID(custom.ID) {
+		t.Errorf("Fail InitSDO should accept a custom type, got %q: %v", custom.ID, err)
+	}
+
+	var bad CommonObjectProperties
+	if err := bad.InitSDO("widget"); err == nil || bad.ID != "" {
+		t.Error("Fail InitSDO should reject an unknown type without setting the id")
+	}
+}
@@ -23,6 +23,7 @@ type Identity struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	objects.RolesProperty
 	IdentityClass      string   `json:"identity_class,omitempty" bson:"identity_class,omitempty"`
 	Sectors            []string `json:"sectors,omitempty" bson:"sectors,omitempty"`
@@ -35,7 +36,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Identity) GetPropertyList() []string {
-	return []string{"name", "description", "roles", "identity_class", "sectors", "contact_information"}
+	return []string{"name", "description", "x_descriptions", "roles", "identity_class", "sectors", "contact_information"}
 }
 
 // ----------------------------------------------------------------------
@@ -51,3 +52,12 @@ func New() *Identity {
 	obj.InitSDO("identity")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Identity) Clone() *Identity {
+	return objects.DeepCopy(o).(*Identity)
+}
@@ -8,6 +8,7 @@ package identity
 import (
 	"fmt"
 
+	"github.com/freetaxii/libstix2/objects"
 	"github.com/freetaxii/libstix2/vocabs"
 )
 
@@ -31,15 +32,15 @@ func (o *Identity) Valid(debug bool) (bool, int, []string) {
 	resultDetails = append(resultDetails, dBase...)
 
 	// Verify object Name property is present
-	// _, pName, dName := o.NameProperty.VerifyExists()
-	// problemsFound += pName
-	// resultDetails = append(resultDetails, dName...)
+	_, pName, dName := o.NameProperty.VerifyExists()
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
 
 	if o.IdentityClass == "" {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The identity_class property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("identity_class")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		// Validate that identity_class is from the vocabulary
@@ -23,6 +23,7 @@ type Indicator struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	IndicatorTypes []string `json:"indicator_types,omitempty" bson:"indicator_types,omitempty"`
 	Pattern        string   `json:"pattern,omitempty" bson:"pattern,omitempty"`
 	PatternType    string   `json:"pattern_type,omitempty" bson:"pattern_type,omitempty"`
@@ -38,7 +39,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Indicator) GetPropertyList() []string {
-	return []string{"name", "description", "indicator_types", "pattern", "pattern_type", "pattern_version", "valid_from", "valid_until", "kill_chain_phases"}
+	return []string{"name", "description", "x_descriptions", "indicator_types", "pattern", "pattern_type", "pattern_version", "valid_from", "valid_until", "kill_chain_phases"}
 }
 
 // ----------------------------------------------------------------------
@@ -55,3 +56,12 @@ func New() *Indicator {
 	obj.InitSDO("indicator")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Indicator) Clone() *Indicator {
+	return objects.DeepCopy(o).(*Indicator)
+}
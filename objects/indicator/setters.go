@@ -7,7 +7,10 @@ package indicator
 
 import (
 	"errors"
+	"fmt"
+	"regexp"
 
+	"github.com/freetaxii/libstix2/defs"
 	"github.com/freetaxii/libstix2/objects"
 )
 
@@ -27,10 +30,13 @@ func (o *Indicator) AddTypes(values interface{}) error {
 
 /*
 SetPattern - This method takes in a string value representing a complete and
-valid STIX pattern and will set the pattern property to that value.
+valid STIX pattern and will set the pattern property to that value. If the
+pattern type is stix and no pattern version has been set, the pattern version
+is set to the current STIX version.
 */
 func (o *Indicator) SetPattern(s string) error {
 	o.Pattern = s
+	o.defaultPatternVersion()
 	return nil
 }
 
@@ -44,6 +50,7 @@ func (o *Indicator) SetPatternType(s string) error {
 		return errors.New("the supplied pattern type is not one of stix, snort, or yara")
 	}
 	o.PatternType = s
+	o.defaultPatternVersion()
 	return nil
 }
 
@@ -53,9 +60,13 @@ the pattern used in this indicator and will set the pattern_version property to
 that value.
 
 For patterns that do not have a formal specification, the build or code version
-that the pattern is known to work with SHOULD be used.
+that the pattern is known to work with SHOULD be used. When the pattern type is
+stix the version must be a STIX version number, like "2.1".
 */
 func (o *Indicator) SetPatternVersion(s string) error {
+	if o.PatternType == "stix" && !stixVersionRegex.MatchString(s) {
+		return fmt.Errorf("the pattern version %q is not a valid STIX version", s)
+	}
 	o.PatternVersion = s
 	return nil
 }
@@ -99,3 +110,24 @@ func (o *Indicator) SetValidUntil(t interface{}) error {
 	o.ValidUntil = ts
 	return nil
 }
+
+// ----------------------------------------------------------------------
+// Private Methods
+// ----------------------------------------------------------------------
+
+/*
+stixVersionRegex - This regular expression matches a STIX version number.
+*/
+var stixVersionRegex = regexp.MustCompile(`^[0-9]+\.[0-9]+$`)
+
+/*
+defaultPatternVersion - This method will set the pattern version to the current
+STIX version if the pattern type is stix and the pattern version is not set.
+STIX patterns that do not say which version they use are read as the version
+of the object, and some pattern matchers reject them.
+*/
+func (o *Indicator) defaultPatternVersion() {
+	if o.PatternType == "stix" && o.PatternVersion == "" {
+		o.PatternVersion = defs.CurrentSTIXVersion
+	}
+}
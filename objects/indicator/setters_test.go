@@ -64,3 +64,24 @@ func TestSetPatternVersion(t *testing.T) {
 
 // TODO Finish fleshing this out. We need the valid from and valid until tests
 // both the positive and negative tests.
+
+// TestDefaultPatternVersion -
+func TestDefaultPatternVersion(t *testing.T) {
+	i := New()
+	i.SetPatternType("stix")
+	i.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+
+	if got := i.PatternVersion; got != "2.1" {
+		t.Errorf("Fail Indicator pattern version should default to 2.1, got %q", got)
+	}
+
+	if err := i.SetPatternVersion("two"); err == nil || i.PatternVersion != "2.1" {
+		t.Error("Fail Indicator should reject a malformed STIX pattern version")
+	}
+
+	i2 := New()
+	i2.SetPatternType("yara")
+	if i2.PatternVersion != "" {
+		t.Error("Fail Indicator pattern version should not default for yara patterns")
+	}
+}
@@ -32,11 +32,16 @@ func (o *Indicator) Valid(debug bool) (bool, int, []string) {
 	problemsFound += pBase
 	resultDetails = append(resultDetails, dBase...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	if len(o.IndicatorTypes) == 0 {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The indicator_types property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("indicator_types")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		str := fmt.Sprintf("++ The indicator_types property is required and is present")
@@ -71,6 +76,18 @@ func (o *Indicator) Valid(debug bool) (bool, int, []string) {
 		}
 	}
 
+	// pattern_version is optional, but a STIX pattern version must be well formed
+	if o.PatternType == "stix" && o.PatternVersion != "" {
+		if !stixVersionRegex.MatchString(o.PatternVersion) {
+			problemsFound++
+			str := fmt.Sprintf("-- The pattern version '%s' is not a valid STIX version", o.PatternVersion)
+			resultDetails = append(resultDetails, str)
+		} else {
+			str := fmt.Sprintf("++ The pattern version property contains a valid STIX version")
+			resultDetails = append(resultDetails, str)
+		}
+	}
+
 	if o.ValidFrom == "" {
 		problemsFound++
 		str := fmt.Sprintf("-- The valid from property is required but missing")
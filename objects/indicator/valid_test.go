@@ -5,7 +5,12 @@
 
 package indicator
 
-import "testing"
+import (
+	"strings"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects"
+)
 
 // ----------------------------------------------------------------------
 // Tests
@@ -356,3 +361,26 @@ func TestValidValidUntil5(t *testing.T) {
 		t.Log(err)
 	}
 }
+
+/*
+TestValidNameLength - Make sure we get a value of false when the optional name
+is longer than objects.MaxNameLength.
+*/
+func TestValidNameLength(t *testing.T) {
+	i := New()
+	i.IndicatorTypes = append(i.IndicatorTypes, "malicious-activity")
+	i.Pattern = "[file:hashes.'SHA-256' = 'e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855']"
+	i.PatternType = "stix"
+	i.ValidFrom = "2019-09-24T20:49:12.123456Z"
+
+	if got, _, err := i.Valid(false); got != true {
+		t.Error("Fail Indicator without a name should be valid")
+		t.Log(err)
+	}
+
+	i.Name = strings.Repeat("a", objects.MaxNameLength+1)
+	if got, _, err := i.Valid(false); got != false {
+		t.Error("Fail Indicator name longer than MaxNameLength should be invalid")
+		t.Log(err)
+	}
+}
@@ -23,6 +23,7 @@ type Infrastructure struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	InfrastructureTypes []string `json:"infrastructure_types,omitempty" bson:"infrastructure_types,omitempty"`
 	objects.AliasesProperty
 	objects.KillChainPhasesProperty
@@ -35,7 +36,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Infrastructure) GetPropertyList() []string {
-	return []string{"name", "description", "infrastructure_types", "aliases", "kill_chain_phases", "first_seen", "last_seen"}
+	return []string{"name", "description", "x_descriptions", "infrastructure_types", "aliases", "kill_chain_phases", "first_seen", "last_seen"}
 }
 
 // ----------------------------------------------------------------------
@@ -52,3 +53,12 @@ func New() *Infrastructure {
 	obj.InitSDO("infrastructure")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Infrastructure) Clone() *Infrastructure {
+	return objects.DeepCopy(o).(*Infrastructure)
+}
@@ -8,6 +8,7 @@ package infrastructure
 import (
 	"fmt"
 
+	"github.com/freetaxii/libstix2/objects"
 	"github.com/freetaxii/libstix2/vocabs"
 )
 
@@ -35,11 +36,16 @@ func (o *Infrastructure) Valid(debug bool) (bool, int, []string) {
 	// problemsFound += pName
 	// resultDetails = append(resultDetails, dName...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	if len(o.InfrastructureTypes) == 0 {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The infrastructure_types property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("infrastructure_types")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		str := fmt.Sprintf("++ The infrastructure_types property is required and is present")
@@ -51,7 +57,7 @@ func (o *Infrastructure) Valid(debug bool) (bool, int, []string) {
 			if !validVocab[infraType] {
 				// this is a SHOULD not a MUST so we won't add it as a problem
 				// problemsFound++
-				str := fmt.Sprintf("-- The infrastructure type '%s' is not in the allowed vocabulary", infraType)
+				str := fmt.Sprintf("** The infrastructure type '%s' is not in the allowed vocabulary", infraType)
 				resultDetails = append(resultDetails, str)
 			}
 		}
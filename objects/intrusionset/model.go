@@ -23,6 +23,7 @@ type IntrusionSet struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	objects.AliasesProperty
 	objects.SeenProperties
 	objects.GoalsProperty
@@ -36,7 +37,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *IntrusionSet) GetPropertyList() []string {
-	return []string{"name", "description", "aliases", "first_seen", "last_seen", "goals", "resource_level", "primary_motivation", "secondary_motivations"}
+	return []string{"name", "description", "x_descriptions", "aliases", "first_seen", "last_seen", "goals", "resource_level", "primary_motivation", "secondary_motivations"}
 }
 
 // ----------------------------------------------------------------------
@@ -53,3 +54,12 @@ func New() *IntrusionSet {
 	obj.InitSDO("intrusion-set")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *IntrusionSet) Clone() *IntrusionSet {
+	return objects.DeepCopy(o).(*IntrusionSet)
+}
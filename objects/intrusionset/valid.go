@@ -29,6 +29,11 @@ func (o *IntrusionSet) Valid(debug bool) (bool, int, []string) {
 	// problemsFound += pName
 	// resultDetails = append(resultDetails, dName...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	if problemsFound > 0 {
 		return false, problemsFound, resultDetails
 	}
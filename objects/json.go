@@ -6,9 +6,25 @@
 package objects
 
 import (
+	"bytes"
 	"encoding/json"
 )
 
+// ----------------------------------------------------------------------
+// Define Types
+// ----------------------------------------------------------------------
+
+// MarshalOptions - This type controls how EncodeWithOptions() writes JSON. The
+// zero value writes compact JSON without escaping HTML characters, so that
+// STIX patterns that contain < > or & are written as they are.
+//
+// Indent     = Pretty print the JSON with four spaces per level
+// EscapeHTML = Escape < > and & as \u003c \u003e and \u0026
+type MarshalOptions struct {
+	Indent     bool
+	EscapeHTML bool
+}
+
 // ----------------------------------------------------------------------
 // Public Functions - JSON Decoders
 // ----------------------------------------------------------------------
@@ -51,6 +67,30 @@ func Decode(data []byte) (*CommonObjectProperties, error) {
 	return &o, nil
 }
 
+// ----------------------------------------------------------------------
+// Public Functions - JSON Encoders
+// ----------------------------------------------------------------------
+
+// EncodeWithOptions - This function will encode any STIX object, bundle, or
+// TAXII resource into JSON using the options given. Each object also has an
+// EncodeWithOptions() method that calls this function.
+func EncodeWithOptions(v interface{}, opts MarshalOptions) ([]byte, error) {
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(opts.EscapeHTML)
+	if opts.Indent {
+		enc.SetIndent("", "    ")
+	}
+
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+
+	// The encoder always adds a new line after the value, which Marshal does not
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
 // FindCustomProperties - This method will return a map that includes just the
 // custom properties for a given STIX object. It takes in the raw JSON byte array
 // and a slice of string that includes the keys to remove.
@@ -0,0 +1,36 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestEncodeWithOptions - Make sure the default options write compact JSON
+// without escaping the HTML characters found in STIX patterns.
+func TestEncodeWithOptions(t *testing.T) {
+	v := map[string]string{"pattern": "[file:size < 100 AND file:name = 'a&b']"}
+
+	data, err := EncodeWithOptions(v, MarshalOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"pattern":"[file:size < 100 AND file:name = 'a&b']"}`
+	if string(data) != want {
+		t.Errorf("Fail compact encoding\n got: %s\nwant: %s", data, want)
+	}
+
+	data, _ = EncodeWithOptions(v, MarshalOptions{EscapeHTML: true})
+	if strings.Contains(string(data), "<") || !strings.Contains(string(data), `\u0026`) {
+		t.Errorf("Fail HTML characters were not escaped: %s", data)
+	}
+
+	data, _ = EncodeWithOptions(v, MarshalOptions{Indent: true})
+	if !strings.Contains(string(data), "\n    \"pattern\"") || strings.HasSuffix(string(data), "\n") {
+		t.Errorf("Fail indented encoding is wrong: %s", data)
+	}
+}
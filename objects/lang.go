@@ -0,0 +1,31 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Language Tags
+// ----------------------------------------------------------------------
+
+// IsLangValid - This function will take in a language tag and return true if
+// it is a valid BCP 47 language tag as defined in RFC 5646, like "en",
+// "en-US", "zh-Hant-TW" or "x-klingon". Language tags are case insensitive.
+// The tag is parsed with golang.org/x/text/language, so the subtags are also
+// checked against the registry that it carries. That parser also accepts an
+// underscore in place of a hyphen, which BCP 47 does not allow, so those tags
+// are rejected first.
+func IsLangValid(s string) bool {
+	if s == "" || strings.Contains(s, "_") {
+		return false
+	}
+	_, err := language.Parse(s)
+	return err == nil
+}
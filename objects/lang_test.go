@@ -0,0 +1,46 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import "testing"
+
+// TestIsLangValid - Make sure well formed language tags are accepted and
+// malformed ones are rejected.
+func TestIsLangValid(t *testing.T) {
+	valid := []string{"en", "EN-us", "de-CH-1901", "zh-Hant-TW", "es-419", "sr-Latn-RS", "x-klingon", "en-US-x-twain", "i-klingon", "zh-yue-HK"}
+	for _, v := range valid {
+		if !IsLangValid(v) {
+			t.Errorf("Fail %q should be a valid language tag", v)
+		}
+	}
+
+	invalid := []string{"", "e", "english-language", "en_US", "en-", "-en", "en--US", "x", "en-US-x", "en-US-US"}
+	for _, v := range invalid {
+		if IsLangValid(v) {
+			t.Errorf("Fail %q should not be a valid language tag", v)
+		}
+	}
+}
+
+// TestSetLang - Make sure SetLang only stores valid tags and that ValidSDO
+// checks the property.
+func TestSetLang(t *testing.T) {
+	var o CommonObjectProperties
+	o.InitSDO("report")
+
+	if err := o.SetLang("fr-CA"); err != nil || o.GetLang() != "fr-CA" {
+		t.Errorf("Fail SetLang did not accept a valid tag: %v", err)
+	}
+
+	if err := o.SetLang("french"); err == nil || o.GetLang() != "fr-CA" {
+		t.Error("Fail SetLang should reject an invalid tag without changing the property")
+	}
+
+	o.Lang = "en_US"
+	if valid, _, _ := o.ValidSDO(false); valid {
+		t.Error("Fail ValidSDO should reject an invalid lang")
+	}
+}
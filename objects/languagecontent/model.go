@@ -54,3 +54,12 @@ func New() *LanguageContent {
 	obj.InitSDO("language-content")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *LanguageContent) Clone() *LanguageContent {
+	return objects.DeepCopy(o).(*LanguageContent)
+}
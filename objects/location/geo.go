@@ -0,0 +1,137 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package location
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// earthRadiusKm - The mean radius of the earth in kilometers.
+const earthRadiusKm = 6371.0
+
+// ----------------------------------------------------------------------
+// Public Functions - Geo
+// ----------------------------------------------------------------------
+
+/*
+Distance - This function will return the great-circle distance in kilometers
+between two locations, using the haversine formula on their latitude and
+longitude properties. An error is returned if either location is missing or
+does not have coordinates.
+*/
+func Distance(a, b *Location) (float64, error) {
+	if a == nil || b == nil {
+		return 0, errors.New("two locations are required to find the distance")
+	}
+
+	if !a.HasCoordinates() {
+		return 0, fmt.Errorf("the location %s does not have coordinates", a.ID)
+	}
+	if !b.HasCoordinates() {
+		return 0, fmt.Errorf("the location %s does not have coordinates", b.ID)
+	}
+
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := (b.Latitude - a.Latitude) * math.Pi / 180
+	dLong := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLong/2)*math.Sin(dLong/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h))), nil
+}
+
+/*
+InferRegion - This function will return a best-effort STIX region-ov value for
+the coordinates of the location, or an empty string if the location does not
+have coordinates or they do not fall in any of the known regions. It uses a
+small built-in table of bounding boxes and makes no network calls, so it is
+only approximate, especially near borders and for small islands. It is meant
+to enrich sparse location data, not to replace real geocoding.
+*/
+func InferRegion(l *Location) string {
+	if !l.HasCoordinates() {
+		return ""
+	}
+
+	for _, b := range regionBoxes {
+		if l.Latitude >= b.minLat && l.Latitude <= b.maxLat &&
+			l.Longitude >= b.minLong && l.Longitude <= b.maxLong {
+			return b.region
+		}
+	}
+	return ""
+}
+
+// ----------------------------------------------------------------------
+// Private Variables
+// ----------------------------------------------------------------------
+
+/*
+regionBox - This type defines a bounding box in decimal degrees and the region
+that it maps to.
+*/
+type regionBox struct {
+	region  string
+	minLat  float64
+	maxLat  float64
+	minLong float64
+	maxLong float64
+}
+
+/*
+regionBoxes - This table holds coarse bounding boxes for the regions in the
+region-ov vocabulary. The boxes overlap, so they are checked in order and the
+first match wins, which is why the more specific boxes come first. The southern
+United States is covered by a few narrow boxes that stop at the Mexican border
+and the Florida Straits, and these are checked before the larger caribbean and
+central-america boxes that reach past them.
+*/
+var regionBoxes = []regionBox{
+	{"antarctica", -90, -60, -180, 180},
+	{"australia-new-zealand", -44, -10, 112, 154},
+	{"australia-new-zealand", -48, -34, 165, 179},
+	{"melanesia", -12, 0, 140, 156},
+	{"melanesia", -23, -5, 155, 180},
+	{"micronesia", 0, 22, 130, 180},
+	{"polynesia", -30, 30, -180, -130},
+	{"south-eastern-asia", -11, 28.5, 92, 141},
+	{"northern-america", 24.4, 32.5, -87.7, -79.9},
+	{"northern-america", 26, 32.5, -97.8, -87.7},
+	{"northern-america", 28.7, 32.5, -100.5, -97.8},
+	{"northern-america", 31.75, 32.5, -108.2, -100.5},
+	{"northern-america", 31.4, 32.5, -111, -108.2},
+	{"caribbean", 17, 27, -85, -59},
+	{"caribbean", 10, 17, -70, -59},
+	{"central-america", 7, 32.5, -118, -77},
+	{"northern-america", 24, 84, -170, -50},
+	{"northern-america", 59, 84, -73, -11},
+	{"south-america", -56, 13, -82, -34},
+	{"northern-europe", 49.9, 61, -11, 2},
+	{"northern-europe", 63, 67, -25, -13},
+	{"northern-europe", 54, 72, 4, 32},
+	{"southern-europe", 35, 43.8, -10, 3.3},
+	{"southern-europe", 36, 46, 6.6, 18.6},
+	{"southern-europe", 34, 46, 13, 23},
+	{"western-europe", 42, 54, -5, 15.1},
+	{"northern-africa", 19, 38, -18, 34.3},
+	{"northern-africa", 8, 22, 21, 38.5},
+	{"western-asia", 29, 43, 26, 50},
+	{"western-asia", 12.5, 30, 34.3, 60},
+	{"eastern-africa", -27, 19, 29, 52},
+	{"middle-africa", -18, 24, 8, 29},
+	{"southern-africa", -35, -17, 11, 29},
+	{"western-africa", 4, 24, -18, 8},
+	{"southern-asia", 25, 37.5, 44, 63},
+	{"southern-asia", 5, 30, 60, 92},
+	{"southern-asia", 23, 38, 60, 75},
+	{"central-asia", 35, 55.5, 46, 80.5},
+	{"eastern-asia", 18, 54, 73, 150},
+	{"eastern-europe", 41, 82, 15, 180},
+}
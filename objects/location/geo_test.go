@@ -0,0 +1,121 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package location
+
+import (
+	"math"
+	"testing"
+
+	"github.com/freetaxii/libstix2/vocabs"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestDistance - Make sure the distance between London and Paris is about 344km
+and that a location without coordinates is an error.
+*/
+func TestDistance(t *testing.T) {
+	london := New()
+	london.SetCoordinates(51.5074, -0.1278)
+
+	paris := New()
+	paris.SetCoordinates(48.8566, 2.3522)
+
+	d, err := Distance(london, paris)
+	if err != nil {
+		t.Fatalf("Fail Distance returned an error: %v", err)
+	}
+	if math.Abs(d-343.5) > 1 {
+		t.Errorf("Fail expected about 343.5km between London and Paris, got %.1f", d)
+	}
+
+	if d2, _ := Distance(paris, london); d2 != d {
+		t.Errorf("Fail distance should be symmetric: %v | %v", d, d2)
+	}
+
+	if _, err := Distance(london, New()); err == nil {
+		t.Error("Fail Distance should require coordinates")
+	}
+
+	if _, err := Distance(london, nil); err == nil {
+		t.Error("Fail Distance should return an error for a nil location")
+	}
+	if _, err := Distance(nil, paris); err == nil {
+		t.Error("Fail Distance should return an error for a nil location")
+	}
+
+	if err := london.SetCoordinates(91, 0); err == nil {
+		t.Error("Fail SetCoordinates should reject a latitude over 90")
+	}
+}
+
+/*
+TestInferRegion - Make sure the coordinates of some well known cities map to
+the expected region.
+*/
+func TestInferRegion(t *testing.T) {
+	cities := []struct {
+		name   string
+		lat    float64
+		long   float64
+		region string
+	}{
+		{"London", 51.5074, -0.1278, "northern-europe"},
+		{"Paris", 48.8566, 2.3522, "western-europe"},
+		{"Madrid", 40.4168, -3.7038, "southern-europe"},
+		{"Warsaw", 52.2297, 21.0122, "eastern-europe"},
+		{"Cairo", 30.0444, 31.2357, "northern-africa"},
+		{"Nairobi", -1.2921, 36.8219, "eastern-africa"},
+		{"Lagos", 6.5244, 3.3792, "western-africa"},
+		{"Kinshasa", -4.4419, 15.2663, "middle-africa"},
+		{"Johannesburg", -26.2041, 28.0473, "southern-africa"},
+		{"Riyadh", 24.7136, 46.6753, "western-asia"},
+		{"Delhi", 28.6139, 77.2090, "southern-asia"},
+		{"Tashkent", 41.2995, 69.2401, "central-asia"},
+		{"Tokyo", 35.6762, 139.6503, "eastern-asia"},
+		{"Singapore", 1.3521, 103.8198, "south-eastern-asia"},
+		{"Sydney", -33.8688, 151.2093, "australia-new-zealand"},
+		{"New York", 40.7128, -74.0060, "northern-america"},
+		{"Houston", 29.7604, -95.3698, "northern-america"},
+		{"New Orleans", 29.9511, -90.0715, "northern-america"},
+		{"San Antonio", 29.4241, -98.4936, "northern-america"},
+		{"El Paso", 31.7619, -106.4850, "northern-america"},
+		{"Tucson", 32.2226, -110.9747, "northern-america"},
+		{"Orlando", 28.5383, -81.3792, "northern-america"},
+		{"Jacksonville", 30.3322, -81.6557, "northern-america"},
+		{"Miami", 25.7617, -80.1918, "northern-america"},
+		{"Key West", 24.5551, -81.7800, "northern-america"},
+		{"Monterrey", 25.6866, -100.3161, "central-america"},
+		{"Chihuahua", 28.6320, -106.0691, "central-america"},
+		{"Ciudad Juarez", 31.6904, -106.4245, "central-america"},
+		{"Nassau", 25.0443, -77.3504, "caribbean"},
+		{"Mexico City", 19.4326, -99.1332, "central-america"},
+		{"Havana", 23.1136, -82.3666, "caribbean"},
+		{"Sao Paulo", -23.5505, -46.6333, "south-america"},
+	}
+
+	for _, c := range cities {
+		l := New()
+		l.SetCoordinates(c.lat, c.long)
+		if got := InferRegion(l); got != c.region {
+			t.Errorf("Fail expected %s to be in %s, got %q", c.name, c.region, got)
+		}
+	}
+
+	vocab := vocabs.GetRegionVocab()
+	for _, b := range regionBoxes {
+		if !vocab[b.region] {
+			t.Errorf("Fail %s is not in the region vocabulary", b.region)
+		}
+	}
+
+	if got := InferRegion(New()); got != "" {
+		t.Errorf("Fail a location without coordinates should not have a region, got %q", got)
+	}
+}
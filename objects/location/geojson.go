@@ -0,0 +1,128 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package location
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// geoJSONCircleSegments - The number of points used to draw the polygon for a
+// location with a precision.
+const geoJSONCircleSegments = 32
+
+// ----------------------------------------------------------------------
+// Define GeoJSON Types
+// ----------------------------------------------------------------------
+
+/*
+geoJSONFeature - This type is the GeoJSON Feature, from RFC 7946, that
+ToGeoJSON() writes.
+*/
+type geoJSONFeature struct {
+	Type       string            `json:"type"`
+	ID         string            `json:"id,omitempty"`
+	Geometry   geoJSONGeometry   `json:"geometry"`
+	Properties geoJSONProperties `json:"properties"`
+}
+
+/*
+geoJSONGeometry - This type is a GeoJSON Point or Polygon, the coordinates are
+in longitude, latitude order.
+*/
+type geoJSONGeometry struct {
+	Type        string      `json:"type"`
+	Coordinates interface{} `json:"coordinates"`
+}
+
+/*
+geoJSONProperties - This type holds the properties of the location that are
+copied to the feature.
+*/
+type geoJSONProperties struct {
+	Name               string  `json:"name,omitempty"`
+	Description        string  `json:"description,omitempty"`
+	Precision          float64 `json:"precision,omitempty"`
+	Region             string  `json:"region,omitempty"`
+	Country            string  `json:"country,omitempty"`
+	AdministrativeArea string  `json:"administrative_area,omitempty"`
+	City               string  `json:"city,omitempty"`
+	StreetAddress      string  `json:"street_address,omitempty"`
+	PostalCode         string  `json:"postal_code,omitempty"`
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - GeoJSON
+// ----------------------------------------------------------------------
+
+/*
+ToGeoJSON - This function will return the location as a GeoJSON Feature so
+that it can be plotted by mapping tools. A location without a precision is a
+Point. A location with a precision, which STIX gives in meters, is a Polygon
+that approximates the circle of that radius around the coordinates. The other
+properties of the location are copied to the properties of the feature. An
+error is returned if no location is provided or it does not have coordinates.
+*/
+func ToGeoJSON(l *Location) ([]byte, error) {
+	if l == nil {
+		return nil, errors.New("no location was provided to convert")
+	}
+
+	if !l.HasCoordinates() {
+		return nil, fmt.Errorf("the location %s does not have coordinates", l.ID)
+	}
+
+	f := geoJSONFeature{
+		Type: "Feature",
+		ID:   l.ID,
+		Properties: geoJSONProperties{
+			Name:               l.Name,
+			Description:        l.Description,
+			Precision:          l.Precision,
+			Region:             l.Region,
+			Country:            l.Country,
+			AdministrativeArea: l.AdministrativeArea,
+			City:               l.City,
+			StreetAddress:      l.StreetAddress,
+			PostalCode:         l.PostalCode,
+		},
+	}
+
+	if l.Precision > 0 {
+		f.Geometry = geoJSONGeometry{Type: "Polygon", Coordinates: [][][2]float64{circle(l, l.Precision/1000)}}
+	} else {
+		f.Geometry = geoJSONGeometry{Type: "Point", Coordinates: [2]float64{l.Longitude, l.Latitude}}
+	}
+
+	return json.Marshal(f)
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+circle - This function will return the closed ring of points, in longitude,
+latitude order, that are radiusKm from the coordinates of the location. The
+points go counterclockwise from north, as RFC 7946 requires for the outer ring
+of a Polygon.
+*/
+func circle(l *Location, radiusKm float64) [][2]float64 {
+	lat := l.Latitude * math.Pi / 180
+	long := l.Longitude * math.Pi / 180
+	d := radiusKm / earthRadiusKm
+
+	ring := make([][2]float64, 0, geoJSONCircleSegments+1)
+	for i := 0; i < geoJSONCircleSegments; i++ {
+		bearing := -2 * math.Pi * float64(i) / geoJSONCircleSegments
+		pLat := math.Asin(math.Sin(lat)*math.Cos(d) + math.Cos(lat)*math.Sin(d)*math.Cos(bearing))
+		pLong := long + math.Atan2(math.Sin(bearing)*math.Sin(d)*math.Cos(lat), math.Cos(d)-math.Sin(lat)*math.Sin(pLat))
+		ring = append(ring, [2]float64{pLong * 180 / math.Pi, pLat * 180 / math.Pi})
+	}
+	return append(ring, ring[0])
+}
@@ -0,0 +1,85 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package location
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestToGeoJSON - Make sure a location is written as a Point, or as a Polygon
+of the right radius when it has a precision, and that coordinates are required.
+*/
+func TestToGeoJSON(t *testing.T) {
+	l := New()
+	l.SetName("London")
+	l.SetCoordinates(51.5074, -0.1278)
+
+	data, err := ToGeoJSON(l)
+	if err != nil {
+		t.Fatalf("Fail ToGeoJSON returned an error: %v", err)
+	}
+
+	var point struct {
+		Type     string
+		Geometry struct {
+			Type        string
+			Coordinates []float64
+		}
+		Properties map[string]interface{}
+	}
+	json.Unmarshal(data, &point)
+	if point.Type != "Feature" || point.Geometry.Type != "Point" || point.Properties["name"] != "London" {
+		t.Errorf("Fail expected a Point feature, got %s", data)
+	}
+	if len(point.Geometry.Coordinates) != 2 || point.Geometry.Coordinates[0] != -0.1278 {
+		t.Errorf("Fail expected the coordinates in longitude, latitude order, got %v", point.Geometry.Coordinates)
+	}
+
+	l.Precision = 10000
+	data, _ = ToGeoJSON(l)
+
+	var polygon struct {
+		Geometry struct {
+			Type        string
+			Coordinates [][][2]float64
+		}
+	}
+	json.Unmarshal(data, &polygon)
+	ring := polygon.Geometry.Coordinates
+	if polygon.Geometry.Type != "Polygon" || len(ring) != 1 || ring[0][0] != ring[0][len(ring[0])-1] {
+		t.Fatalf("Fail expected a closed Polygon, got %s", data)
+	}
+
+	edge := New()
+	edge.SetCoordinates(ring[0][5][1], ring[0][5][0])
+	if d, _ := Distance(l, edge); math.Abs(d-10) > 0.01 {
+		t.Errorf("Fail expected the polygon to be 10km from the center, got %.3f", d)
+	}
+
+	// The shoelace sum is positive when the ring is counterclockwise
+	area := 0.0
+	for i := 0; i < len(ring[0])-1; i++ {
+		area += ring[0][i][0]*ring[0][i+1][1] - ring[0][i+1][0]*ring[0][i][1]
+	}
+	if area <= 0 {
+		t.Errorf("Fail expected the outer ring to be counterclockwise, got a shoelace sum of %f", area)
+	}
+
+	if _, err := ToGeoJSON(New()); err == nil {
+		t.Error("Fail ToGeoJSON should require coordinates")
+	}
+
+	if _, err := ToGeoJSON(nil); err == nil {
+		t.Error("Fail ToGeoJSON should return an error for a nil location")
+	}
+}
@@ -23,6 +23,7 @@ type Location struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	Latitude           float64 `json:"latitude,omitempty" bson:"latitude,omitempty"`
 	Longitude          float64 `json:"longitude,omitempty" bson:"longitude,omitempty"`
 	Precision          float64 `json:"precision,omitempty" bson:"precision,omitempty"`
@@ -40,7 +41,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Location) GetPropertyList() []string {
-	return []string{"name", "description", "latitude", "longitude", "precision", "region", "country", "administrative_area", "city", "street_address", "postal_code"}
+	return []string{"name", "description", "x_descriptions", "latitude", "longitude", "precision", "region", "country", "administrative_area", "city", "street_address", "postal_code"}
 }
 
 // ----------------------------------------------------------------------
@@ -57,3 +58,12 @@ func New() *Location {
 	obj.InitSDO("location")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Location) Clone() *Location {
+	return objects.DeepCopy(o).(*Location)
+}
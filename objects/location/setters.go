@@ -4,3 +4,36 @@
 // found in the LICENSE file in the root of the source tree.
 
 package location
+
+import "fmt"
+
+// ----------------------------------------------------------------------
+// Public Methods - Location - Setters
+// ----------------------------------------------------------------------
+
+/*
+SetCoordinates - This method takes in a latitude and longitude in decimal
+degrees and updates the latitude and longitude properties. The latitude must
+be between -90 and 90 and the longitude between -180 and 180.
+*/
+func (o *Location) SetCoordinates(lat, long float64) error {
+	if lat < -90 || lat > 90 {
+		return fmt.Errorf("the latitude %v is not between -90 and 90", lat)
+	}
+	if long < -180 || long > 180 {
+		return fmt.Errorf("the longitude %v is not between -180 and 180", long)
+	}
+	o.Latitude = lat
+	o.Longitude = long
+	return nil
+}
+
+/*
+HasCoordinates - This method will return true if the latitude or longitude
+property is set. Since both properties are omitted from the JSON when they are
+zero, a location at exactly 0,0 can not be told apart from one without
+coordinates.
+*/
+func (o *Location) HasCoordinates() bool {
+	return o.Latitude != 0 || o.Longitude != 0
+}
@@ -24,6 +24,11 @@ func (o *Location) Valid(debug bool) (bool, int, []string) {
 	problemsFound += pBase
 	resultDetails = append(resultDetails, dBase...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	if problemsFound > 0 {
 		return false, problemsFound, resultDetails
 	}
@@ -0,0 +1,65 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import "sync"
+
+// ----------------------------------------------------------------------
+// Define Types
+// ----------------------------------------------------------------------
+
+// Logger - This interface is used by the library to report diagnostics, like
+// objects that were skipped while decoding a bundle. The keyvals are pairs of
+// keys and values that add context to the message. It matches the shape of
+// most structured loggers so that they can be wrapped with very little code.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// nopLogger - This type is the default Logger and discards everything.
+type nopLogger struct{}
+
+var (
+	loggerMu sync.RWMutex
+	logger   Logger = nopLogger{}
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Logging
+// ----------------------------------------------------------------------
+
+// SetLogger - This function will set the Logger that the library uses for
+// diagnostics. Passing nil will go back to the default, which discards all
+// messages.
+func SetLogger(l Logger) {
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+
+	if l == nil {
+		l = nopLogger{}
+	}
+	logger = l
+}
+
+// GetLogger - This function will return the Logger that the library uses for
+// diagnostics. It never returns nil.
+func GetLogger() Logger {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	return logger
+}
+
+// ----------------------------------------------------------------------
+// Private Methods - nopLogger
+// ----------------------------------------------------------------------
+
+func (nopLogger) Debug(msg string, keyvals ...interface{}) {}
+func (nopLogger) Info(msg string, keyvals ...interface{})  {}
+func (nopLogger) Warn(msg string, keyvals ...interface{})  {}
+func (nopLogger) Error(msg string, keyvals ...interface{}) {}
@@ -23,6 +23,7 @@ type Malware struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	MalwareTypes []string `json:"malware_types,omitempty" bson:"malware_types,omitempty"`
 	IsFamily     bool     `json:"is_family" bson:"is_family"`
 	objects.AliasesProperty
@@ -41,7 +42,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Malware) GetPropertyList() []string {
-	return []string{"name", "description", "malware_types", "is_familly", "aliases", "kill_chain_phases", "first_seen", "last_seen", "os_execution_envs", "architecture_execution_envs", "implementation_languages", "capabilities", "sample_refs"}
+	return []string{"name", "description", "x_descriptions", "malware_types", "is_familly", "aliases", "kill_chain_phases", "first_seen", "last_seen", "os_execution_envs", "architecture_execution_envs", "implementation_languages", "capabilities", "sample_refs"}
 }
 
 // ----------------------------------------------------------------------
@@ -58,3 +59,12 @@ func New() *Malware {
 	obj.InitSDO("malware")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Malware) Clone() *Malware {
+	return objects.DeepCopy(o).(*Malware)
+}
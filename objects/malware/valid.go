@@ -8,6 +8,7 @@ package malware
 import (
 	"fmt"
 
+	"github.com/freetaxii/libstix2/objects"
 	"github.com/freetaxii/libstix2/vocabs"
 )
 
@@ -30,12 +31,23 @@ func (o *Malware) Valid(debug bool) (bool, int, []string) {
 	problemsFound += pBase
 	resultDetails = append(resultDetails, dBase...)
 
+	// Verify object Name property, it is only required for a malware family
+	if o.IsFamily {
+		_, pName, dName := o.NameProperty.VerifyExists()
+		problemsFound += pName
+		resultDetails = append(resultDetails, dName...)
+	} else {
+		_, pName, dName := o.NameProperty.Valid(debug)
+		problemsFound += pName
+		resultDetails = append(resultDetails, dName...)
+	}
+
 	// Verify malware types
 	if len(o.MalwareTypes) == 0 {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The malware_types property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("malware_types")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		str := fmt.Sprintf("++ The malware_types property is required and is present")
@@ -47,7 +59,7 @@ func (o *Malware) Valid(debug bool) (bool, int, []string) {
 			if !validVocab[malwareType] {
 				// this is a SHOULD not a MUST so we won't add it as a problem
 				// problemsFound++
-				str := fmt.Sprintf("-- The malware type '%s' is not in the allowed vocabulary", malwareType)
+				str := fmt.Sprintf("** The malware type '%s' is not in the allowed vocabulary", malwareType)
 				resultDetails = append(resultDetails, str)
 			}
 		}
@@ -6,7 +6,10 @@
 package malware
 
 import (
+	"strings"
 	"testing"
+
+	"github.com/freetaxii/libstix2/objects"
 )
 
 // ----------------------------------------------------------------------
@@ -21,9 +24,13 @@ import (
 // ----------------------------------------------------------------------
 
 /*
-TestValid1 - Make sure we get a value of false when Malware obj is blank.
+TestValid1 - Make sure we get a value of false when Malware obj is blank and
+strict validation requires the malware_types property.
 */
 func TestValid1(t *testing.T) {
+	objects.StrictValidation = true
+	defer func() { objects.StrictValidation = false }()
+
 	m := New()
 	want := false
 
@@ -59,11 +66,15 @@ func TestValid3(t *testing.T) {
 	}
 }
 
-// TestValid3 - MalwareTypes is required
+// TestValid4 - MalwareTypes is required when strict validation is set
 func TestValid4(t *testing.T) {
+	objects.StrictValidation = true
+	defer func() { objects.StrictValidation = false }()
+
 	m := New()
 	want := false
 
+	m.SetName("Poison Ivy")
 	m.SetIsFamily()
 
 	if got, _, err := m.Valid(false); got != want {
@@ -72,17 +83,24 @@ func TestValid4(t *testing.T) {
 	}
 }
 
-// TestValid5 - MalwareTypes should add only of vocab value
+// TestValid5 - MalwareTypes should be from the vocab, but this is a SHOULD so
+// it is only a warning
 func TestValid5(t *testing.T) {
 	m := New()
-	want := false
+	want := true
 	// wantMessage := "the MalwareTypes property should be one of list: adware, backdoor, bot, bootkit, ddos, downloader, dropper, exploit-kit, keylogger, ransomware, remote-access-trojan, resource-exploitation, rogue-security-software, rootkit, screen-capture, spyware, trojan, unknown, virus, webshell, wiper, worm"
 
+	m.SetName("Poison Ivy")
 	m.AddTypes("asdasdasd")
 	m.SetIsFamily()
 
-	if got, _, err := m.Valid(false); got != want {
-		t.Error("Fail Malware Object MalwareTypes added value not from vocab")
+	got, _, err := m.Valid(false)
+	if got != want {
+		t.Error("Fail Malware Object MalwareTypes value not from vocab should only be a warning")
+		t.Log(err)
+	}
+	if len(objects.ValidationWarnings(err)) != 1 {
+		t.Error("Fail Malware Object MalwareTypes value not from vocab should be a warning")
 		t.Log(err)
 	}
 
@@ -98,6 +116,7 @@ func TestValid6(t *testing.T) {
 	want := false
 	// wantMessage := "the ArchitectureExecutionEnvs property should be one of list: alpha, arm, ia-64, mips, powerpc, sparc, x86, x86-64"
 
+	m.SetName("Poison Ivy")
 	m.AddTypes("bot")
 	m.SetIsFamily()
 	m.AddArchitectureExecutionEnvs("llkjlk")
@@ -119,6 +138,7 @@ func TestValid7(t *testing.T) {
 	want := false
 	// wantMessage := "the ImplementationLanguages property should be one of list: applescript, bash, c, c++, c#, go, java, javascript, lua, objective-c, perl, php, powershell, python, ruby, scala, swift, typescript, visual-basic, x86-32, x86-64"
 
+	m.SetName("Poison Ivy")
 	m.AddTypes("bot")
 	m.SetIsFamily()
 	m.AddImplementationLanguages("llkjlk")
@@ -140,6 +160,7 @@ func TestValidCapabilitiesRequired(t *testing.T) {
 	want := false
 	// wantMessage := "the Capabilities property should be one of list: accesses-remote-machines, anti-debugging, anti-disassembly, anti-emulation, anti-memory-forensics, anti-sandbox, anti-vm, captures-input-peripherals, captures-output-peripherals, captures-system-state-data, cleans-traces-of-infection, commits-fraud, communicates-with-c2, compromises-data-availability, compromises-data-integrity, compromises-system-availability, controls-local-machine, degrades-security-software, degrades-system-updates, determines-c2-server, emails-spam, escalates-privileges, evades-av, exfiltrates-data, fingerprints-host, hides-artifacts, hides-executing-code, infects-files, infects-remote-machines, installs-other-components, persists-after-system-reboot, prevents-artifact-access, prevents-artifact-deletion, probes-network-environment, self-modifies, steals-authentication-credentials, violates-system-operational-integrity"
 
+	m.SetName("Poison Ivy")
 	m.AddTypes("bot")
 	m.SetIsFamily()
 	m.AddCapabilities("llkjlk")
@@ -158,6 +179,7 @@ func TestValidCapabilitiesRequired(t *testing.T) {
 // TestValid9 - should be valid
 func TestValid9(t *testing.T) {
 	m := New()
+	m.SetName("Poison Ivy")
 	want := true
 
 	m.AddTypes("bot")
@@ -171,3 +193,40 @@ func TestValid9(t *testing.T) {
 		t.Log(err)
 	}
 }
+
+// TestValidNameRequired - Name is only required for a malware family, and must
+// not be too long
+func TestValidNameRequired(t *testing.T) {
+	m := New()
+	m.AddTypes("bot")
+
+	if got, _, err := m.Valid(false); got != true {
+		t.Error("Fail Malware Object Name is not required for a malware instance")
+		t.Log(err)
+	}
+
+	m.SetIsFamily()
+	if got, _, err := m.Valid(false); got != false {
+		t.Error("Fail Malware Object Name is required for a malware family")
+		t.Log(err)
+	}
+
+	m.SetName(strings.Repeat("a", objects.MaxNameLength+1))
+	if got, _, err := m.Valid(false); got != false {
+		t.Error("Fail Malware Object Name longer than MaxNameLength should be invalid")
+		t.Log(err)
+	}
+
+	m.IsFamily = false
+	if got, _, err := m.Valid(false); got != false {
+		t.Error("Fail Malware Object Name longer than MaxNameLength should be invalid on an instance")
+		t.Log(err)
+	}
+
+	m.SetName("Poison Ivy")
+	m.SetIsFamily()
+	if got, _, err := m.Valid(false); got != true {
+		t.Error("Fail Malware Object family with a Name should be valid")
+		t.Log(err)
+	}
+}
@@ -32,7 +32,8 @@ type MalwareAnalysis struct {
 	AnalysisStarted           string   `json:"analysis_started,omitempty" bson:"analysis_started,omitempty"`
 	AnalysisEnded             string   `json:"analysis_ended,omitempty" bson:"analysis_ended,omitempty"`
 	AVResults                 string   `json:"av_results,omitempty" bson:"av_results,omitempty"`
-	AnalysisSCORefs           string   `json:"analysis_sco_refs,omitempty" bson:"analysis_sco_refs,omitempty"`
+	AnalysisSCORefs           []string `json:"analysis_sco_refs,omitempty" bson:"analysis_sco_refs,omitempty"`
+	SampleRef                 string   `json:"sample_ref,omitempty" bson:"sample_ref,omitempty"`
 }
 
 /*
@@ -41,7 +42,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *MalwareAnalysis) GetPropertyList() []string {
-	return []string{"product", "version", "host_vm_ref", "operating_system_ref", "installed_software_refs", "configuration_version", "modules", "analysis_engine_version", "analysis_definition_version", "submitted", "analysis_started", "analysis_ended", "av_results", "analysis_sco_refs"}
+	return []string{"product", "version", "host_vm_ref", "operating_system_ref", "installed_software_refs", "configuration_version", "modules", "analysis_engine_version", "analysis_definition_version", "submitted", "analysis_started", "analysis_ended", "av_results", "analysis_sco_refs", "sample_ref"}
 }
 
 // ----------------------------------------------------------------------
@@ -58,3 +59,12 @@ func New() *MalwareAnalysis {
 	obj.InitSDO("malware-analysis")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *MalwareAnalysis) Clone() *MalwareAnalysis {
+	return objects.DeepCopy(o).(*MalwareAnalysis)
+}
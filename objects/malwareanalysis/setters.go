@@ -4,3 +4,36 @@
 // found in the LICENSE file in the root of the source tree.
 
 package malwareanalysis
+
+import (
+	"errors"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// ----------------------------------------------------------------------
+// Public Methods
+// ----------------------------------------------------------------------
+
+/*
+AddAnalysisSCORefs - This method takes in a string value, a comma separated
+list of string values, or a slice of string values that represents an id of a
+sco that was captured during the analysis and adds it to the analysis sco refs
+property.
+*/
+func (o *MalwareAnalysis) AddAnalysisSCORefs(values interface{}) error {
+	return objects.AddValuesToList(&o.AnalysisSCORefs, values)
+}
+
+/*
+SetSampleRef - This method takes in a string value that represents the id of
+the file, network traffic, or artifact sco that was analyzed and updates the
+sample ref property.
+*/
+func (o *MalwareAnalysis) SetSampleRef(s string) error {
+	if s == "" {
+		return errors.New("the sample ref property can not be empty")
+	}
+	o.SampleRef = s
+	return nil
+}
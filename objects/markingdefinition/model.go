@@ -50,3 +50,12 @@ func New() *MarkingDefinition {
 	obj.InitSDO("marking-definition")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *MarkingDefinition) Clone() *MarkingDefinition {
+	return objects.DeepCopy(o).(*MarkingDefinition)
+}
@@ -0,0 +1,92 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package markingdefinition
+
+import (
+	"strings"
+
+	"github.com/freetaxii/libstix2/objects"
+	"github.com/freetaxii/libstix2/objects/properties"
+)
+
+// ----------------------------------------------------------------------
+// Define TLP Markings
+// ----------------------------------------------------------------------
+
+// These are the ids of the canonical TLP marking definitions, see the objects
+// package for the TLP 2.0 marking definitions.
+const (
+	TLPWhiteID = objects.TLPWhiteID
+	TLPGreenID = objects.TLPGreenID
+	TLPAmberID = objects.TLPAmberID
+	TLPRedID   = objects.TLPRedID
+)
+
+/*
+tlpLevels - This map holds the TLP levels from the least to the most
+restrictive. TLP 2.0 renamed white to clear and added amber+strict, so both
+are accepted.
+*/
+var tlpLevels = map[string]int{
+	"white":        0,
+	"clear":        0,
+	"green":        1,
+	"amber":        2,
+	"amber+strict": 3,
+	"red":          4,
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - TLP
+// ----------------------------------------------------------------------
+
+/*
+TLPLevelForID - This function will take in the id of a marking definition and
+return the TLP level of it, if it is one of the canonical TLP 1.0 or TLP 2.0
+marking definitions.
+*/
+func TLPLevelForID(id string) (string, bool) {
+	return objects.TLPLevelForID(id)
+}
+
+/*
+TLPRank - This function will take in a TLP level, like "amber", and return its
+rank, where a higher rank is more restrictive. The level is not case
+sensitive.
+*/
+func TLPRank(level string) (int, bool) {
+	rank, found := tlpLevels[strings.ToLower(level)]
+	return rank, found
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - TLP
+// ----------------------------------------------------------------------
+
+/*
+GetTLP - This method will return the TLP level of the marking definition, or
+an empty string if it is not a TLP marking. The definition can either be set
+with SetDefinition() or decoded from JSON.
+*/
+func (o *MarkingDefinition) GetTLP() string {
+	if level, found := TLPLevelForID(o.ID); found {
+		return level
+	}
+
+	if o.DefinitionType != "tlp" {
+		return ""
+	}
+
+	switch d := o.Definition.(type) {
+	case properties.TlpDefinition:
+		return d.Tlp
+	case map[string]interface{}:
+		if s, ok := d["tlp"].(string); ok {
+			return s
+		}
+	}
+	return ""
+}
@@ -47,6 +47,7 @@ type CommonObjectProperties struct {
 	ObjectMarkingRefs  []string               `json:"object_marking_refs,omitempty" bson:"object_marking_refs,omitempty"`
 	GranularMarkings   []GranularMarking      `json:"granular_markings,omitempty" bson:"granular_markings,omitempty"`
 	Extensions         map[string]interface{} `json:"extensions,omitempty" bson:"extensions,omitempty"`
+	Signature          string                 `json:"x_signature,omitempty" bson:"x_signature,omitempty"`
 	Custom             map[string][]byte      `json:"custom,omitempty" bson:"custom,omitempty"`
 	Raw                []byte                 `json:"-" bson:"-"`
 }
@@ -91,6 +92,7 @@ func (o *CommonObjectProperties) GetCommonPropertyList() []string {
 		"object_marking_refs",
 		"granular_markings",
 		"extensions",
+		"x_signature",
 	}
 }
 
@@ -108,31 +110,41 @@ type results struct {
 /*
 InitSDO - This method will initialize a STIX Domain Object by setting all
 of the basic properties and is called by the New() function from each object.
+With strict checks enabled the new id must pass ParseID(), so the type must be
+one this library knows about or a custom type. The created_by_ref is set to the
+default from SetDefaultCreatedByRef(), if any.
 */
 func (o *CommonObjectProperties) InitSDO(objectType string) error {
+	id, err := o.CreateSTIXUUID(objectType)
+	if err != nil {
+		return err
+	}
+
 	if defs.STRICT_TYPES {
-		if valid := ValidObjectType(objectType); valid != true {
-			return fmt.Errorf("invalid object type for InitSDO with strict checks enabled")
+		if _, _, err := ParseID(id); err != nil {
+			return fmt.Errorf("invalid object type %q for InitSDO with strict checks enabled: %w", objectType, err)
 		}
 	}
 
 	o.SetSpecVersion(defs.CurrentSTIXVersion)
 	o.SetObjectType(objectType)
-	o.SetNewSTIXID(objectType)
+	o.SetID(id)
 	o.SetCreatedToCurrentTime()
 	o.SetModified(o.GetCreated())
+	o.CreatedByRef = GetDefaultCreatedByRef()
 	return nil
 }
 
 /*
 InitSRO - This method will initialize a STIX Relationship Object by setting
 all of the basic properties and is called by the New() function from each
-object.
+object. The created_by_ref is set to the default from SetDefaultCreatedByRef(),
+if any.
 */
 func (o *CommonObjectProperties) InitSRO(objectType string) error {
 	if defs.STRICT_TYPES {
 		if valid := ValidObjectType(objectType); valid != true {
-			return fmt.Errorf("invalid object type for InitSRO with strict checks enabled")
+			return fmt.Errorf("invalid object type %q for InitSRO with strict checks enabled", objectType)
 		}
 	}
 
@@ -141,6 +153,7 @@ func (o *CommonObjectProperties) InitSRO(objectType string) error {
 	o.SetNewSTIXID(objectType)
 	o.SetCreatedToCurrentTime()
 	o.SetModified(o.GetCreated())
+	o.CreatedByRef = GetDefaultCreatedByRef()
 	return nil
 }
 
@@ -152,7 +165,7 @@ each object.
 func (o *CommonObjectProperties) InitSCO(objectType string) error {
 	if defs.STRICT_TYPES {
 		if valid := ValidObjectType(objectType); valid != true {
-			return fmt.Errorf("invalid object type for InitSCO with strict checks enabled")
+			return fmt.Errorf("invalid object type %q for InitSCO with strict checks enabled", objectType)
 		}
 	}
 
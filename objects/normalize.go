@@ -0,0 +1,157 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+)
+
+// timeRFC3339MicroFixed - This is the layout that Normalize() uses for
+// timestamps. Unlike defs.TimeRFC3339Micro it always writes all six digits, so
+// the same instant is always written the same way.
+const timeRFC3339MicroFixed = "2006-01-02T15:04:05.000000Z"
+
+// timestampProperties - These are the JSON names of the properties that hold a
+// timestamp and are rewritten by Normalize().
+var timestampProperties = map[string]bool{
+	"created":          true,
+	"modified":         true,
+	"first_seen":       true,
+	"last_seen":        true,
+	"valid_from":       true,
+	"valid_until":      true,
+	"first_observed":   true,
+	"last_observed":    true,
+	"published":        true,
+	"start_time":       true,
+	"stop_time":        true,
+	"analysis_started": true,
+	"analysis_ended":   true,
+	"submitted":        true,
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - Normalization
+// ----------------------------------------------------------------------
+
+// Normalize - This function will clean up an object in place so that objects
+// from different sources are written the same way, which makes storage and
+// de-duplication more reliable. It will trim the white space from all of the
+// strings, rewrite timestamps in UTC with microsecond precision, and normalize
+// the algorithm names and hex digests of hashes. Map keys do not need to be
+// sorted as they are always sorted when the object is encoded to JSON. Custom
+// properties and the raw JSON data are left alone.
+func Normalize(obj STIXObject) error {
+	if obj == nil {
+		return errors.New("can not normalize a nil object")
+	}
+
+	v := reflect.ValueOf(obj)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return errors.New("the object to normalize must be a non-nil pointer")
+	}
+
+	normalizeValue(v.Elem(), "")
+	return nil
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+// normalizeValue - This function will walk the value and normalize each of the
+// strings and hashes that it finds. The name is the JSON name of the property
+// that holds the value.
+func normalizeValue(v reflect.Value, name string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			normalizeValue(v.Elem(), name)
+		}
+
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+
+			tag := strings.Split(f.Tag.Get("json"), ",")[0]
+			if tag == "-" && !f.Anonymous {
+				continue
+			}
+			normalizeValue(v.Field(i), tag)
+		}
+
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			normalizeValue(v.Index(i), name)
+		}
+
+	case reflect.Map:
+		if name == "hashes" && v.Type() == reflect.TypeOf(map[string]string{}) && !v.IsNil() {
+			normalizeHashes(v.Interface().(map[string]string))
+		}
+
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(normalizeString(v.String(), name))
+		}
+	}
+}
+
+// normalizeString - This function will trim the string and, if it is the value
+// of a timestamp property, rewrite it in the fixed microsecond layout.
+func normalizeString(s, name string) string {
+	s = strings.TrimSpace(s)
+	if !timestampProperties[name] || s == "" {
+		return s
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return s
+	}
+	return t.UTC().Format(timeRFC3339MicroFixed)
+}
+
+// normalizeHashes - This function will normalize the algorithm names and
+// digests of the hashes in place. Algorithms that are not known are left as
+// they are. If two entries normalize to the same algorithm, the one whose
+// original name sorts first is kept.
+func normalizeHashes(hashes map[string]string) {
+	keys := make([]string, 0, len(hashes))
+	for k := range hashes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(hashes))
+	for _, k := range keys {
+		name, digest, err := NormalizeHash(k, hashes[k])
+		if err != nil {
+			name, digest = k, strings.TrimSpace(hashes[k])
+		}
+		if _, found := normalized[name]; !found {
+			normalized[name] = digest
+		}
+	}
+
+	for k := range hashes {
+		delete(hashes, k)
+	}
+	for k, v := range normalized {
+		hashes[k] = v
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"testing"
+)
+
+// TestNormalize - Make sure strings are trimmed, timestamps are rewritten in
+// the fixed microsecond layout, and hashes are normalized, including the ones
+// on external references.
+func TestNormalize(t *testing.T) {
+	var o CommonObjectProperties
+	o.ObjectType = " indicator "
+	o.Created = "2016-04-06T22:03:48.1+02:00"
+	o.Modified = "2016-04-06T20:03:48.000Z"
+	o.Labels = []string{"  malicious-activity"}
+	o.ExternalReferences = []ExternalReference{{
+		SourceName: "capec ",
+		Hashes:     map[string]string{"sha256": " ABCDEF ", "x_custom": " Value "},
+	}}
+
+	if err := Normalize(&o); err != nil {
+		t.Fatalf("Fail Normalize returned an error: %v", err)
+	}
+
+	if o.ObjectType != "indicator" || o.Labels[0] != "malicious-activity" || o.ExternalReferences[0].SourceName != "capec" {
+		t.Errorf("Fail strings were not trimmed: %q %q %q", o.ObjectType, o.Labels[0], o.ExternalReferences[0].SourceName)
+	}
+
+	if o.Created != "2016-04-06T20:03:48.100000Z" {
+		t.Errorf("Fail created was not normalized: %s", o.Created)
+	}
+	if o.Modified != "2016-04-06T20:03:48.000000Z" {
+		t.Errorf("Fail modified was not normalized: %s", o.Modified)
+	}
+
+	h := o.ExternalReferences[0].Hashes
+	if h["SHA-256"] != "abcdef" || h["x_custom"] != "Value" || len(h) != 2 {
+		t.Errorf("Fail hashes were not normalized: %v", h)
+	}
+
+	if err := Normalize(nil); err == nil {
+		t.Error("Fail Normalize should reject a nil object")
+	}
+}
@@ -31,10 +31,10 @@ func (o *Note) Valid(debug bool) (bool, int, []string) {
 		resultDetails = append(resultDetails, str)
 	}
 
-	// Verify object refs property is present
-	// _, pObjectRefs, dObjectRefs := o.ObjectRefsProperty.VerifyExists()
-	// problemsFound += pObjectRefs
-	// resultDetails = append(resultDetails, dObjectRefs...)
+	// Verify object refs property is present and each ref is well formed
+	_, pObjectRefs, dObjectRefs := o.ObjectRefsProperty.Valid(debug)
+	problemsFound += pObjectRefs
+	resultDetails = append(resultDetails, dObjectRefs...)
 
 	if problemsFound > 0 {
 		return false, problemsFound, resultDetails
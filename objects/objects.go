@@ -64,8 +64,9 @@ func ValidObjectType(t string) bool {
 		"windows-registry-key": 1,
 		"x509-certificate":     1,
 		// Meta Objects
-		"language-content":   1,
-		"marking-definition": 1,
+		"extension-definition": 1,
+		"language-content":     1,
+		"marking-definition":   1,
 		// Bundle
 		"bundle": 1,
 	}
@@ -76,6 +77,20 @@ func ValidObjectType(t string) bool {
 	return false
 }
 
+// IsSCOType - This function will take in a STIX object type and return true if
+// it is one of the STIX Cyber Observable Object types. SCOs do not have the
+// created and modified properties.
+func IsSCOType(t string) bool {
+	switch t {
+	case "artifact", "autonomous-system", "directory", "domain-name",
+		"email-addr", "email-message", "file", "ipv4-addr", "ipv6-addr",
+		"mac-addr", "mutex", "network-traffic", "process", "software", "url",
+		"user-account", "windows-registry-key", "x509-certificate":
+		return true
+	}
+	return false
+}
+
 // GetCommonProperties - This method will return a pointer to the common
 // properties of this object.
 func (o *CommonObjectProperties) GetCommonProperties() *CommonObjectProperties {
@@ -103,11 +118,11 @@ func GetCurrentSpecVersion() string {
 // returns the current time in RFC 3339 format
 func GetCurrentTime(precision string) string {
 	if precision == "milli" {
-		return time.Now().UTC().Format(defs.TimeRFC3339Milli)
+		return now().UTC().Format(defs.TimeRFC3339Milli)
 	} else if precision == "micro" {
-		return time.Now().UTC().Format(defs.TimeRFC3339Micro)
+		return now().UTC().Format(defs.TimeRFC3339Micro)
 	}
-	return time.Now().UTC().Format(defs.TimeRFC3339)
+	return now().UTC().Format(defs.TimeRFC3339)
 }
 
 // TimeToString - This function takes in a timestamp in either time.Time or string
@@ -53,3 +53,12 @@ func New() *ObservedData {
 	obj.InitSDO("observed-data")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *ObservedData) Clone() *ObservedData {
+	return objects.DeepCopy(o).(*ObservedData)
+}
@@ -50,3 +50,12 @@ func New() *Opinion {
 	obj.InitSDO("opinion")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Opinion) Clone() *Opinion {
+	return objects.DeepCopy(o).(*Opinion)
+}
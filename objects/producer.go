@@ -0,0 +1,54 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ----------------------------------------------------------------------
+// Define Variables
+// ----------------------------------------------------------------------
+
+var (
+	producerMu          sync.RWMutex
+	defaultCreatedByRef string
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Producer Defaults
+// ----------------------------------------------------------------------
+
+// SetDefaultCreatedByRef - This function will set the identity that new SDOs
+// and SROs are attributed to, so that a producer does not need to call
+// SetCreatedByRef() on every object it makes. Objects can still set their own
+// created_by_ref. The id must reference an identity object, passing in an
+// empty string turns the default off.
+func SetDefaultCreatedByRef(id string) error {
+	if id != "" {
+		stixType, _, err := ParseID(id)
+		if err != nil {
+			return err
+		}
+		if stixType != "identity" {
+			return fmt.Errorf("the default created_by_ref must reference an identity object, not a %s object", stixType)
+		}
+	}
+
+	producerMu.Lock()
+	defer producerMu.Unlock()
+	defaultCreatedByRef = id
+	return nil
+}
+
+// GetDefaultCreatedByRef - This function will return the identity that new
+// SDOs and SROs are attributed to, or an empty string if there is no default.
+func GetDefaultCreatedByRef() string {
+	producerMu.RLock()
+	defer producerMu.RUnlock()
+	return defaultCreatedByRef
+}
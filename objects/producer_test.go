@@ -0,0 +1,57 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import "testing"
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestSetDefaultCreatedByRef - Make sure new SDOs and SROs are attributed to the
+default identity, that it can be overridden, and that only identities are
+accepted.
+*/
+func TestSetDefaultCreatedByRef(t *testing.T) {
+	id := "identity--311b2d2d-f010-4473-83ec-1edf84858f4c"
+	if err := SetDefaultCreatedByRef(id); err != nil {
+		t.Fatal(err)
+	}
+	defer SetDefaultCreatedByRef("")
+
+	var sdo, sro, sco CommonObjectProperties
+	sdo.InitSDO("indicator")
+	sro.InitSRO("relationship")
+	sco.InitSCO("file")
+
+	if sdo.CreatedByRef != id || sro.CreatedByRef != id {
+		t.Errorf("Fail expected new SDOs and SROs to be created by %s, got %q and %q", id, sdo.CreatedByRef, sro.CreatedByRef)
+	}
+	if sco.CreatedByRef != "" {
+		t.Error("Fail SCOs should not get a created_by_ref")
+	}
+
+	other := "identity--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f"
+	sdo.SetCreatedByRef(other)
+	if sdo.CreatedByRef != other {
+		t.Error("Fail an object should be able to override the default")
+	}
+
+	if err := SetDefaultCreatedByRef("malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b"); err == nil {
+		t.Error("Fail the default should have to be an identity")
+	}
+	if GetDefaultCreatedByRef() != id {
+		t.Error("Fail a rejected default should not replace the current one")
+	}
+
+	SetDefaultCreatedByRef("")
+	var plain CommonObjectProperties
+	plain.InitSDO("indicator")
+	if plain.CreatedByRef != "" {
+		t.Error("Fail an empty default should turn attribution off")
+	}
+}
@@ -5,7 +5,12 @@
 
 package objects
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
 
 // ----------------------------------------------------------------------
 // Aliases Property
@@ -123,6 +128,34 @@ func (o *BelongsToRefsProperty) AddBelongsToRefs(values interface{}) error {
 	return AddValuesToList(&o.BelongsToRefs, values)
 }
 
+// ----------------------------------------------------------------------
+// Defanged Property
+// ----------------------------------------------------------------------
+
+// DefangedProperty - A property used by one or more STIX Cyber-observable
+// Objects that records if the data contained in the object has been defanged.
+type DefangedProperty struct {
+	Defanged bool `json:"defanged,omitempty" bson:"defanged,omitempty"`
+}
+
+// SetDefanged - This method sets the defanged boolean to true.
+func (o *DefangedProperty) SetDefanged() error {
+	o.Defanged = true
+	return nil
+}
+
+// SetNotDefanged - This method sets the defanged boolean to false.
+func (o *DefangedProperty) SetNotDefanged() error {
+	o.Defanged = false
+	return nil
+}
+
+// GetDefanged - This method returns the current value of the defanged
+// property.
+func (o *DefangedProperty) GetDefanged() bool {
+	return o.Defanged
+}
+
 // ----------------------------------------------------------------------
 // Description Property
 // ----------------------------------------------------------------------
@@ -170,6 +203,41 @@ func (o *DescriptionProperty) Compare(obj2 *DescriptionProperty, debug bool) (bo
 	return true, 0, r.resultDetails
 }
 
+// ----------------------------------------------------------------------
+// Descriptions Property
+// ----------------------------------------------------------------------
+
+// DescriptionsProperty - A property used by the STIX objects that have a
+// description to keep the description in other languages. They are kept in the
+// custom x_descriptions property, keyed by their BCP 47 language tag. The
+// language of the description property itself is given by the lang property of
+// the object.
+type DescriptionsProperty struct {
+	Descriptions map[string]string `json:"x_descriptions,omitempty" bson:"x_descriptions,omitempty"`
+}
+
+// SetDescriptionForLang - This method takes in a BCP 47 language tag and a
+// text description in that language and adds it to the x_descriptions
+// property. An error is returned if the language tag is not valid.
+func (o *DescriptionsProperty) SetDescriptionForLang(tag, s string) error {
+	if !IsLangValid(tag) {
+		return fmt.Errorf("the language tag %q is not a valid RFC 5646 language tag", tag)
+	}
+
+	if o.Descriptions == nil {
+		o.Descriptions = make(map[string]string)
+	}
+	o.Descriptions[tag] = s
+	return nil
+}
+
+// GetDescriptionForLang - This method returns the description in the language
+// given and true, or an empty string and false if there is not one.
+func (o *DescriptionsProperty) GetDescriptionForLang(tag string) (string, bool) {
+	s, found := o.Descriptions[tag]
+	return s, found
+}
+
 // ----------------------------------------------------------------------
 // Goals Property
 // ----------------------------------------------------------------------
@@ -333,10 +401,16 @@ type NameProperty struct {
 	Name string `json:"name,omitempty" bson:"name,omitempty"`
 }
 
+// MaxNameLength - This is the longest name, in characters, that the Valid()
+// method of the name property will accept. Set it to 0 to allow any length.
+var MaxNameLength = 1024
+
 // SetName - This method takes in a string value representing a name of the
-// object and updates the name property.
+// object and updates the name property. Leading and trailing white space is
+// removed and any run of white space inside the name is replaced with a
+// single space.
 func (o *NameProperty) SetName(s string) error {
-	o.Name = s
+	o.Name = strings.Join(strings.Fields(s), " ")
 	return nil
 }
 
@@ -345,6 +419,42 @@ func (o *NameProperty) GetName() string {
 	return o.Name
 }
 
+// Valid - This method will verify that the name, if present, is not longer
+// than MaxNameLength. It will return a boolean, an integer that tracks the
+// number of problems found, and a slice of strings that contain the detailed
+// results, whether good or bad.
+func (o *NameProperty) Valid(debug bool) (bool, int, []string) {
+	var r *results = new(results)
+	r.debug = debug
+
+	if n := utf8.RuneCountInString(o.Name); MaxNameLength > 0 && n > MaxNameLength {
+		str := fmt.Sprintf("-- The name property is %d characters long, the limit is %d", n, MaxNameLength)
+		logProblem(r, str)
+	}
+
+	if r.problemsFound > 0 {
+		return false, r.problemsFound, r.resultDetails
+	}
+	return true, 0, r.resultDetails
+}
+
+// VerifyExists - This method will verify that the name property on an object
+// is present and passes Valid(). It is used by the objects that require a
+// name. It will return a boolean, an integer that tracks the number of
+// problems found, and a slice of strings that contain the detailed results,
+// whether good or bad.
+func (o *NameProperty) VerifyExists() (bool, int, []string) {
+	if o.Name == "" {
+		return false, 1, []string{"-- The name property is required but missing"}
+	}
+
+	valid, problemsFound, resultDetails := o.Valid(false)
+	if !valid {
+		return false, problemsFound, resultDetails
+	}
+	return true, 0, []string{"++ The name property is required and is present"}
+}
+
 // Compare - This method will compare two properties to make sure they are the
 // same and will return a boolean, an integer that tracks the number of problems
 // found, and a slice of strings that contain the detailed results, whether good or
@@ -385,6 +495,66 @@ func (o *ObjectRefsProperty) AddObjectRefs(values interface{}) error {
 	return AddValuesToList(&o.ObjectRefs, values)
 }
 
+// AddObjectRef - This method takes in a single STIX identifier and adds it to
+// the object refs property.
+func (o *ObjectRefsProperty) AddObjectRef(s string) error {
+	if s == "" {
+		return errors.New("the object ref can not be empty")
+	}
+	o.ObjectRefs = append(o.ObjectRefs, s)
+	return nil
+}
+
+// GetObjectRefs - This method will return the object refs property.
+func (o *ObjectRefsProperty) GetObjectRefs() []string {
+	return o.ObjectRefs
+}
+
+// Contains - This method will return true if the object refs property has the
+// STIX identifier.
+func (o *ObjectRefsProperty) Contains(id string) bool {
+	for _, v := range o.ObjectRefs {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Count - This method will return the number of entries in the object refs
+// property.
+func (o *ObjectRefsProperty) Count() int {
+	return len(o.ObjectRefs)
+}
+
+// Valid - This method will verify that the object refs property is present and
+// that each entry is a STIX identifier in the form type--uuid. It will return a
+// boolean, an integer that tracks the number of problems found, and a slice of
+// strings that contain the detailed results, whether good or bad.
+func (o *ObjectRefsProperty) Valid(debug bool) (bool, int, []string) {
+	var r *results = new(results)
+	r.debug = debug
+
+	if len(o.ObjectRefs) == 0 {
+		logProblem(r, "-- The object refs property is required but missing")
+		return false, r.problemsFound, r.resultDetails
+	}
+	logValid(r, "++ The object refs property is required and is present")
+
+	for _, v := range o.ObjectRefs {
+		if !isRefValid(v) {
+			logProblem(r, fmt.Sprintf("-- The object ref %q is not a valid STIX identifier", v))
+		} else {
+			logValid(r, fmt.Sprintf("++ The object ref %q is a valid STIX identifier", v))
+		}
+	}
+
+	if r.problemsFound > 0 {
+		return false, r.problemsFound, r.resultDetails
+	}
+	return true, 0, r.resultDetails
+}
+
 // Compare - This method will compare two properties to make sure they are the
 // same and will return a boolean, an integer that tracks the number of problems
 // found, and a slice of strings that contain the detailed results, whether good or
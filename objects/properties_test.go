@@ -0,0 +1,127 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// TestObjectRefsValid - Make sure well formed refs are accepted and that a
+// missing list, a ref without the "--" separator, and a ref with a bad UUID are
+// all reported.
+func TestObjectRefsValid(t *testing.T) {
+	var o ObjectRefsProperty
+	if valid, _, _ := o.Valid(false); valid {
+		t.Error("Fail empty object refs should be invalid")
+	}
+
+	o.AddObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	o.AddObjectRef("x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b")
+	if valid, _, details := o.Valid(true); !valid {
+		t.Error("Fail well formed object refs should be valid")
+		t.Log(details)
+	}
+
+	tests := []string{
+		"indicator8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f",
+		"indicator--8e2e2d2b-17d4-4cbf-938f",
+		"indicator--8e2e2d2b17d44cbf938f98ee46b3cd3f",
+		"Indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f",
+		"--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f",
+	}
+
+	for _, v := range tests {
+		o := ObjectRefsProperty{ObjectRefs: []string{v}}
+		if valid, problems, _ := o.Valid(false); valid || problems != 1 {
+			t.Errorf("Fail object ref %q should be invalid", v)
+		}
+	}
+}
+
+// TestDescriptionForLang - Make sure localized descriptions are stored by
+// language tag and written as x_descriptions.
+func TestDescriptionForLang(t *testing.T) {
+	var o struct {
+		DescriptionProperty
+		DescriptionsProperty
+	}
+	o.SetDescription("A phishing campaign")
+
+	if err := o.SetDescriptionForLang("de", "Eine Phishing-Kampagne"); err != nil {
+		t.Fatal(err)
+	}
+	if err := o.SetDescriptionForLang("german", "x"); err == nil {
+		t.Error("Fail SetDescriptionForLang should reject an invalid language tag")
+	}
+
+	if s, found := o.GetDescriptionForLang("de"); !found || s != "Eine Phishing-Kampagne" {
+		t.Error("Fail German description was not stored")
+	}
+
+	data, _ := json.Marshal(&o)
+	want := `{"description":"A phishing campaign","x_descriptions":{"de":"Eine Phishing-Kampagne"}}`
+	if string(data) != want {
+		t.Errorf("Fail JSON\n got: %s\nwant: %s", data, want)
+	}
+
+	// The description property is shared with the TAXII resources, which must
+	// not have the custom x_descriptions property
+	var d DescriptionProperty
+	if err := json.Unmarshal([]byte(`{"description":"d","x_descriptions":{"de":"x"}}`), &d); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := json.Marshal(&d); string(data) != `{"description":"d"}` {
+		t.Errorf("Fail the description property should not have x_descriptions, got %s", data)
+	}
+}
+
+// TestNameProperty - Make sure SetName normalizes white space and that names
+// that are missing or too long are reported.
+func TestNameProperty(t *testing.T) {
+	var o NameProperty
+	o.SetName("  Poison \t Ivy\n ")
+	if got := o.GetName(); got != "Poison Ivy" {
+		t.Errorf("Fail SetName did not normalize white space: %q", got)
+	}
+
+	if valid, _, _ := o.VerifyExists(); !valid {
+		t.Error("Fail name should be valid")
+	}
+
+	defer func(n int) { MaxNameLength = n }(MaxNameLength)
+	MaxNameLength = 5
+	if valid, problems, _ := o.Valid(false); valid || problems != 1 {
+		t.Error("Fail a name longer than MaxNameLength should be invalid")
+	}
+
+	o.SetName("   ")
+	if valid, _, _ := o.VerifyExists(); valid {
+		t.Error("Fail a name of only white space should be missing")
+	}
+}
+
+// TestObjectRefsContainsCount - Make sure membership and the number of refs are
+// reported.
+func TestObjectRefsContainsCount(t *testing.T) {
+	var o ObjectRefsProperty
+	if o.Count() != 0 || o.Contains("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f") {
+		t.Error("Fail an empty object refs property should have no refs")
+	}
+
+	o.AddObjectRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+	o.AddObjectRef("malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b")
+
+	if o.Count() != 2 {
+		t.Errorf("Fail expected 2 refs, got %d", o.Count())
+	}
+	if !o.Contains("malware--31b940d4-6f7f-459a-80ea-9c1f17b5891b") {
+		t.Error("Fail expected the malware ref to be found")
+	}
+	if o.Contains("tool--31b940d4-6f7f-459a-80ea-9c1f17b5891b") {
+		t.Error("Fail expected the tool ref to not be found")
+	}
+}
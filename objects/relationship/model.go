@@ -23,6 +23,7 @@ type Relationship struct {
 	objects.CommonObjectProperties
 	RelationshipType string `json:"relationship_type,omitempty" bson:"relationship_type,omitempty"`
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	SourceRef string `json:"source_ref,omitempty" bson:"source_ref,omitempty"`
 	TargetRef string `json:"target_ref,omitempty" bson:"target_ref,omitempty"`
 	StartTime string `json:"start_time,omitempty" bson:"start_time,omitempty"`
@@ -35,7 +36,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Relationship) GetPropertyList() []string {
-	return []string{"relationship_type", "description", "source_ref", "target_ref", "start_time", "stop_time"}
+	return []string{"relationship_type", "description", "x_descriptions", "source_ref", "target_ref", "start_time", "stop_time"}
 }
 
 // ----------------------------------------------------------------------
@@ -52,3 +53,12 @@ func New() *Relationship {
 	obj.InitSRO("relationship")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Relationship) Clone() *Relationship {
+	return objects.DeepCopy(o).(*Relationship)
+}
@@ -0,0 +1,99 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReportOptions - This type controls how FormatValidationReportWithOptions()
+// writes a report. Verbose also lists the checks that passed and Color wraps
+// the labels in ANSI color codes for terminals.
+type ReportOptions struct {
+	Verbose bool
+	Color   bool
+}
+
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorYellow = "\033[33m"
+	colorGreen  = "\033[32m"
+)
+
+// reportLabels - These are the labels, and their colors, for each of the
+// severity prefixes of the detailed results.
+var reportLabels = map[string][2]string{
+	"--": {"ERROR", colorRed},
+	"**": {"WARNING", colorYellow},
+	"++": {"OK", colorGreen},
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - Validation Reports
+// ----------------------------------------------------------------------
+
+// FormatValidationReport - This function will take in the results of a Valid()
+// method and return them as a plain text report, with a summary line followed
+// by the errors and warnings.
+func FormatValidationReport(ok bool, problems int, details []string) string {
+	return FormatValidationReportWithOptions(ok, problems, details, ReportOptions{})
+}
+
+// FormatValidationReportWithOptions - This function will take in the results
+// of a Valid() method and return them as a report. The summary line gives the
+// result and the number of problems and warnings, it is followed by one line
+// per detailed result with the labels aligned. Entries that passed, or that do
+// not have a severity prefix, are only listed in verbose mode.
+func FormatValidationReportWithOptions(ok bool, problems int, details []string, opts ReportOptions) string {
+	var sb strings.Builder
+
+	result, color := "VALID", colorGreen
+	if !ok {
+		result, color = "INVALID", colorRed
+	}
+	warnings := len(ValidationWarnings(details))
+	fmt.Fprintf(&sb, "%s (%d %s, %d %s)\n", paint(result, color, opts.Color),
+		problems, plural(problems, "problem"), warnings, plural(warnings, "warning"))
+
+	for _, v := range details {
+		label, text := "", v
+		if len(v) >= 3 && v[2] == ' ' {
+			if l, found := reportLabels[v[:2]]; found {
+				label = paint(fmt.Sprintf("%-7s", l[0]), l[1], opts.Color)
+				text = v[3:]
+			}
+		}
+
+		if !opts.Verbose && (label == "" || strings.HasPrefix(v, "++")) {
+			continue
+		}
+		if label == "" {
+			label = strings.Repeat(" ", 7)
+		}
+		fmt.Fprintf(&sb, "  %s  %s\n", label, text)
+	}
+	return sb.String()
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+func paint(s, color string, enabled bool) string {
+	if !enabled {
+		return s
+	}
+	return color + s + colorReset
+}
+
+func plural(n int, s string) string {
+	if n == 1 {
+		return s
+	}
+	return s + "s"
+}
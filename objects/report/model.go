@@ -23,6 +23,7 @@ type Report struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	ReportTypes []string `json:"report_types,omitempty" bson:"report_types,omitempty"`
 	Published   string   `json:"published,omitempty" bson:"published,omitempty"`
 	objects.ObjectRefsProperty
@@ -34,7 +35,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Report) GetPropertyList() []string {
-	return []string{"name", "description", "report_types", "published", "object_refs"}
+	return []string{"name", "description", "x_descriptions", "report_types", "published", "object_refs"}
 }
 
 // ----------------------------------------------------------------------
@@ -51,3 +52,12 @@ func New() *Report {
 	obj.InitSDO("report")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Report) Clone() *Report {
+	return objects.DeepCopy(o).(*Report)
+}
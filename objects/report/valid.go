@@ -5,7 +5,11 @@
 
 package report
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/freetaxii/libstix2/objects"
+)
 
 // ----------------------------------------------------------------------
 // Public Methods
@@ -31,12 +35,17 @@ func (o *Report) Valid(debug bool) (bool, int, []string) {
 	// problemsFound += pName
 	// resultDetails = append(resultDetails, dName...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	// Verify report types
 	if len(o.ReportTypes) == 0 {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The report_types property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("report_types")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		str := fmt.Sprintf("++ The report_types property is required and is present")
@@ -52,10 +61,10 @@ func (o *Report) Valid(debug bool) (bool, int, []string) {
 		resultDetails = append(resultDetails, str)
 	}
 
-	// Verify object refs property is present
-	// _, pObjectRefs, dObjectRefs := o.ObjectRefsProperty.VerifyExists()
-	// problemsFound += pObjectRefs
-	// resultDetails = append(resultDetails, dObjectRefs...)
+	// Verify object refs property is present and each ref is well formed
+	_, pObjectRefs, dObjectRefs := o.ObjectRefsProperty.Valid(debug)
+	problemsFound += pObjectRefs
+	resultDetails = append(resultDetails, dObjectRefs...)
 
 	if problemsFound > 0 {
 		return false, problemsFound, resultDetails
@@ -0,0 +1,44 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"strings"
+	"testing"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestFormatValidationReport - Make sure the plain report only lists errors and
+warnings and the verbose report lists everything.
+*/
+func TestFormatValidationReport(t *testing.T) {
+	details := []string{
+		"++ The type property is present",
+		"-- The id property is required but missing",
+		"** The labels property is required but missing",
+	}
+
+	want := "INVALID (1 problem, 1 warning)\n" +
+		"  ERROR    The id property is required but missing\n" +
+		"  WARNING  The labels property is required but missing\n"
+	if got := FormatValidationReport(false, 1, details); got != want {
+		t.Errorf("Fail plain report, got:\n%s", got)
+	}
+
+	got := FormatValidationReportWithOptions(false, 1, details, ReportOptions{Verbose: true})
+	if !strings.Contains(got, "  OK       The type property is present\n") {
+		t.Errorf("Fail verbose report should list the checks that passed, got:\n%s", got)
+	}
+
+	got = FormatValidationReportWithOptions(true, 0, nil, ReportOptions{Color: true})
+	if got != colorGreen+"VALID"+colorReset+" (0 problems, 0 warnings)\n" {
+		t.Errorf("Fail colored report, got %q", got)
+	}
+}
@@ -5,6 +5,12 @@
 
 package artifact
 
+import (
+	"fmt"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
 // ----------------------------------------------------------------------
 // Public Methods - Artifact - Setters
 // ----------------------------------------------------------------------
@@ -57,12 +63,25 @@ func (o *Artifact) SetDecryptionKey(s string) error {
 /*
 AddHash - This method takes in two parameters and adds the hash to the map. The
 first is a string value representing a hash type from the STIX hashing-algorithm-ov
-vocabulary. The second is a string value representing the actual hash.
+vocabulary. The second is a string value representing the actual hash. The
+algorithm name is normalized, so "sha256" is recorded as "SHA-256", and hex
+digests are lower cased so the same hash from different feeds matches. Unknown
+algorithms are rejected unless they start with "x_", and the digest must be a
+hex string of the right length for the algorithm.
 */
 func (o *Artifact) AddHash(k, v string) error {
+	name, digest, err := objects.NormalizeHash(k, v)
+	if err != nil {
+		return err
+	}
+
+	if !objects.IsHashDigestValid(name, digest) {
+		return fmt.Errorf("the digest \"%s\" is not a valid %s hash", digest, name)
+	}
+
 	if o.Hashes == nil {
 		o.Hashes = make(map[string]string, 0)
 	}
-	o.Hashes[k] = v
+	o.Hashes[name] = digest
 	return nil
 }
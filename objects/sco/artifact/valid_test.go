@@ -112,14 +112,29 @@ TestHashManagement - Test hash map operations
 func TestHashManagement(t *testing.T) {
 	m := New()
 
-	m.AddHash("MD5", "test-md5")
-	m.AddHash("SHA-256", "test-sha256")
+	m.AddHash("MD5", "d41d8cd98f00b204e9800998ecf8427e")
+	m.AddHash("SHA-256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
 
 	if len(m.Hashes) != 2 {
 		t.Error("Fail: Should have 2 hashes")
 	}
 
-	if m.Hashes["MD5"] != "test-md5" {
+	if m.Hashes["MD5"] != "d41d8cd98f00b204e9800998ecf8427e" {
 		t.Error("Fail: MD5 hash not set correctly")
 	}
 }
+
+/*
+TestAddHashDigestLength - Make sure a digest of the wrong length is rejected
+*/
+func TestAddHashDigestLength(t *testing.T) {
+	m := New()
+
+	if err := m.AddHash("MD5", "abc"); err == nil {
+		t.Error("Fail: short MD5 digest should be rejected")
+	}
+
+	if len(m.Hashes) != 0 {
+		t.Error("Fail: rejected digest should not be added")
+	}
+}
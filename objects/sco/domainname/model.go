@@ -22,6 +22,7 @@ individual properties.
 type DomainName struct {
 	objects.CommonObjectProperties
 	objects.ValueProperty
+	objects.DefangedProperty
 	objects.ResolvesToRefsProperty
 }
 
@@ -31,7 +32,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *DomainName) GetPropertyList() []string {
-	return []string{"value", "resolves_to_refs"}
+	return []string{"value", "resolves_to_refs", "defanged"}
 }
 
 // ----------------------------------------------------------------------
@@ -27,6 +27,7 @@ type EmailAddress struct {
 	objects.CommonObjectProperties
 	// TODO: Add specific properties for EmailAddress based on STIX 2.1 spec section 6.4
 	objects.ValueProperty
+	objects.DefangedProperty
 	DisplayName string `json:"display_name,omitempty" bson:"display_name,omitempty"`
 	objects.BelongsToRefsProperty
 }
@@ -37,8 +38,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *EmailAddress) GetPropertyList() []string {
-	// TODO: Update with actual property names
-	return []string{}
+	return []string{"value", "display_name", "belongs_to_refs", "defanged"}
 }
 
 // ----------------------------------------------------------------------
@@ -5,8 +5,39 @@
 
 package emailaddr
 
+import "github.com/freetaxii/libstix2/objects"
+
 // ----------------------------------------------------------------------
 // Public Methods - EmailAddress - Setters
 // ----------------------------------------------------------------------
 
 // TODO: Add setter methods for EmailAddress properties
+
+// ----------------------------------------------------------------------
+// Public Methods - Defanging
+// ----------------------------------------------------------------------
+
+/*
+Defang - This method will rewrite the value of this email address to its defanged
+representation and set the defanged property to true. Calling it on an object
+that is already defanged does nothing.
+*/
+func (o *EmailAddress) Defang() error {
+	if o.Defanged {
+		return nil
+	}
+	o.Value = objects.DefangEmailAddress(o.Value)
+	return o.SetDefanged()
+}
+
+/*
+Refang - This method will undo Defang by restoring the original value of this
+email address and setting the defanged property to false.
+*/
+func (o *EmailAddress) Refang() error {
+	if !o.Defanged {
+		return nil
+	}
+	o.Value = objects.Refang(o.Value)
+	return o.SetNotDefanged()
+}
@@ -6,6 +6,8 @@
 package file
 
 import (
+	"fmt"
+
 	"github.com/freetaxii/libstix2/objects"
 )
 
@@ -109,12 +111,25 @@ func (o *File) SetContentRef(s string) error {
 /*
 AddHash - This method takes in two parameters and adds the hash to the map. The
 first is a string value representing a hash type from the STIX hashing-algorithm-ov
-vocabulary. The second is a string value representing the actual hash of the file.
+vocabulary. The second is a string value representing the actual hash. The
+algorithm name is normalized, so "sha256" is recorded as "SHA-256", and hex
+digests are lower cased so the same hash from different feeds matches. Unknown
+algorithms are rejected unless they start with "x_", and the digest must be a
+hex string of the right length for the algorithm.
 */
 func (o *File) AddHash(k, v string) error {
+	name, digest, err := objects.NormalizeHash(k, v)
+	if err != nil {
+		return err
+	}
+
+	if !objects.IsHashDigestValid(name, digest) {
+		return fmt.Errorf("the digest \"%s\" is not a valid %s hash", digest, name)
+	}
+
 	if o.Hashes == nil {
 		o.Hashes = make(map[string]string, 0)
 	}
-	o.Hashes[k] = v
+	o.Hashes[name] = digest
 	return nil
 }
@@ -0,0 +1,63 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package file
+
+import "testing"
+
+// TestAddHashNormalizes - Make sure different spellings of the same algorithm
+// and mixed case digests end up as the same hash entry.
+func TestAddHashNormalizes(t *testing.T) {
+	m := New()
+	m.AddHash("sha256", "E3B0C44298FC1C149AFBF4C8996FB92427AE41E4649B934CA495991B7852B855")
+	m.AddHash("SHA-256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+
+	if len(m.Hashes) != 1 {
+		t.Fatalf("Fail expected 1 hash entry, got %d", len(m.Hashes))
+	}
+
+	if got := m.Hashes["SHA-256"]; got != "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" {
+		t.Errorf("Fail hash digest was not lower cased: %s", got)
+	}
+}
+
+// TestAddHashUnknownAlgorithm - Make sure unknown algorithms are rejected
+// unless they are custom x_ algorithms.
+func TestAddHashUnknownAlgorithm(t *testing.T) {
+	m := New()
+
+	if err := m.AddHash("whirlpool", "abc"); err == nil {
+		t.Error("Fail unknown hashing algorithm should be rejected")
+	}
+
+	if err := m.AddHash("x_whirlpool", "abc"); err != nil {
+		t.Errorf("Fail custom hashing algorithm should be accepted: %v", err)
+	}
+}
+
+// TestAddHashDigestLength - Make sure the digest length is checked.
+func TestAddHashDigestLength(t *testing.T) {
+	m := New()
+
+	if err := m.AddHash("MD5", "abc"); err == nil {
+		t.Error("Fail short MD5 digest should be rejected")
+	}
+
+	if err := m.AddHash("MD5", "d41d8cd98f00b204e9800998ecf8427"); err == nil {
+		t.Error("Fail MD5 digest one character short should be rejected")
+	}
+
+	if err := m.AddHash("SHA-256", "zzb0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"); err == nil {
+		t.Error("Fail SHA-256 digest that is not hex should be rejected")
+	}
+
+	if len(m.Hashes) != 0 {
+		t.Errorf("Fail rejected digests should not be added, got %v", m.Hashes)
+	}
+
+	if err := m.AddHash("md5", "D41D8CD98F00B204E9800998ECF8427E"); err != nil {
+		t.Errorf("Fail valid MD5 digest should be accepted: %v", err)
+	}
+}
@@ -131,18 +131,18 @@ TestHashManagement - Test hash map operations
 func TestHashManagement(t *testing.T) {
 	m := New()
 
-	m.AddHash("MD5", "test-md5")
-	m.AddHash("SHA-256", "test-sha256")
+	m.AddHash("MD5", "d41d8cd98f00b204e9800998ecf8427e")
+	m.AddHash("SHA-256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
 
 	if len(m.Hashes) != 2 {
 		t.Error("Fail: Should have 2 hashes")
 	}
 
-	if m.Hashes["MD5"] != "test-md5" {
+	if m.Hashes["MD5"] != "d41d8cd98f00b204e9800998ecf8427e" {
 		t.Error("Fail: MD5 hash not set correctly")
 	}
 
-	if m.Hashes["SHA-256"] != "test-sha256" {
+	if m.Hashes["SHA-256"] != "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" {
 		t.Error("Fail: SHA-256 hash not set correctly")
 	}
 }
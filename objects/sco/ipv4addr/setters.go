@@ -4,3 +4,34 @@
 // found in the LICENSE file in the root of the source tree.
 
 package ipv4addr
+
+import "github.com/freetaxii/libstix2/objects"
+
+// ----------------------------------------------------------------------
+// Public Methods - Defanging
+// ----------------------------------------------------------------------
+
+/*
+Defang - This method will rewrite the value of this IPv4 address to its defanged
+representation and set the defanged property to true. Calling it on an object
+that is already defanged does nothing.
+*/
+func (o *IPv4Addr) Defang() error {
+	if o.Defanged {
+		return nil
+	}
+	o.Value = objects.DefangDomain(o.Value)
+	return o.SetDefanged()
+}
+
+/*
+Refang - This method will undo Defang by restoring the original value of this
+IPv4 address and setting the defanged property to false.
+*/
+func (o *IPv4Addr) Refang() error {
+	if !o.Defanged {
+		return nil
+	}
+	o.Value = objects.Refang(o.Value)
+	return o.SetNotDefanged()
+}
@@ -0,0 +1,66 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package urlobject
+
+import "testing"
+
+// TestDefang - Make sure the scheme and host are defanged and the defanged
+// property is set.
+func TestDefang(t *testing.T) {
+	m := New()
+	m.SetValue("https://www.example.com/research/index.html")
+	m.Defang()
+
+	want := "hxxps://www[.]example[.]com/research/index.html"
+	if m.Value != want {
+		t.Errorf("Fail UrlObject Defang: got %s want %s", m.Value, want)
+	}
+
+	if !m.Defanged {
+		t.Error("Fail UrlObject Defang should set the defanged property")
+	}
+
+	// Defanging twice should not change the value
+	m.Defang()
+	if m.Value != want {
+		t.Errorf("Fail UrlObject Defang is not idempotent: got %s", m.Value)
+	}
+}
+
+// TestRefang - Make sure Refang restores the original value.
+func TestRefang(t *testing.T) {
+	m := New()
+	want := "http://x4z9arb.cn/4712/"
+	m.SetValue(want)
+	m.Defang()
+	m.Refang()
+
+	if m.Value != want {
+		t.Errorf("Fail UrlObject Refang: got %s want %s", m.Value, want)
+	}
+
+	if m.Defanged {
+		t.Error("Fail UrlObject Refang should clear the defanged property")
+	}
+}
+
+// TestRefangKeepsPath - Make sure a "[.]" or "(.)" in the path or query is not
+// changed by a Defang and Refang round trip.
+func TestRefangKeepsPath(t *testing.T) {
+	m := New()
+	want := "http://example.com/a[.]b?q=x(.)y"
+	m.SetValue(want)
+	m.Defang()
+
+	if m.Value != "hxxp://example[.]com/a[.]b?q=x(.)y" {
+		t.Errorf("Fail UrlObject Defang changed the path or query: got %s", m.Value)
+	}
+
+	m.Refang()
+	if m.Value != want {
+		t.Errorf("Fail UrlObject Refang: got %s want %s", m.Value, want)
+	}
+}
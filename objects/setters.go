@@ -5,7 +5,14 @@
 
 package objects
 
-import "github.com/google/uuid"
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/freetaxii/libstix2/defs"
+	"github.com/google/uuid"
+)
 
 // ----------------------------------------------------------------------
 // Public Methods - DatastoreIDProperty - Setters
@@ -73,10 +80,10 @@ func (o *CommonObjectProperties) GetSpecVersion() string {
 
 // CreateSTIXUUID - This method takes in a string value representing a STIX
 // object type and creates and returns a new ID based on the approved STIX UUIDv4
-// format.
+// format, or the UUID version set with SetIDVersion().
 func (o *CommonObjectProperties) CreateSTIXUUID(s string) (string, error) {
 	// TODO add check to validate that s is a valid type
-	id := s + "--" + uuid.New().String()
+	id := s + "--" + newUUID()
 	return id, nil
 }
 
@@ -104,6 +111,23 @@ func (o *CommonObjectProperties) SetNewSTIXID(s string) error {
 	return nil
 }
 
+// SetDeterministicID - This method takes in the contributing properties of a
+// STIX Cyber Observable Object and sets the id property to a UUIDv5 based
+// identifier in the namespace set with SetIDNamespace(). The object type must
+// already be set.
+func (o *CommonObjectProperties) SetDeterministicID(contributing map[string]interface{}) error {
+	if o.ObjectType == "" {
+		return errors.New("the object type must be set before creating a deterministic id")
+	}
+
+	id, err := CreateDeterministicSTIXUUID(o.ObjectType, contributing)
+	if err != nil {
+		return err
+	}
+	o.ID = id
+	return nil
+}
+
 // SetID - This method takes in a string value representing an existing STIX id
 // and updates the id property for the object.
 func (o *CommonObjectProperties) SetID(s string) error {
@@ -121,8 +145,17 @@ func (o *CommonObjectProperties) GetID() string {
 // ----------------------------------------------------------------------
 
 // SetCreatedByRef - This method takes in a string value representing a STIX
-// identifier and updates the Created By Ref property.
+// identifier and updates the Created By Ref property. The identifier must
+// reference an identity object, if it does not an error is returned and the
+// property is not changed.
 func (o *CommonObjectProperties) SetCreatedByRef(s string) error {
+	stixType, _, err := ParseID(s)
+	if err != nil {
+		return err
+	}
+	if stixType != "identity" {
+		return fmt.Errorf("the created_by_ref property must reference an identity object, not a %s object", stixType)
+	}
 	o.CreatedByRef = s
 	return nil
 }
@@ -187,6 +220,28 @@ func (o *CommonObjectProperties) GetModified() string {
 	return o.Modified
 }
 
+// Touch - This method will update the modified property to the current time,
+// or to one microsecond after the existing modified timestamp if the clock has
+// not moved past it, so that each new version of an object always has a later
+// modified timestamp than the last. It will return an error if the created
+// property is not set.
+func (o *CommonObjectProperties) Touch() error {
+	if o.Created == "" {
+		return errors.New("the created property must be set before the object can be touched")
+	}
+
+	t := now().UTC()
+	if created, err := time.Parse(time.RFC3339Nano, o.Created); err == nil && t.Before(created) {
+		t = created
+	}
+	if modified, err := time.Parse(time.RFC3339Nano, o.Modified); err == nil && !t.After(modified) {
+		t = modified.Add(time.Microsecond)
+	}
+
+	o.Modified = t.UTC().Format(defs.TimeRFC3339Micro)
+	return nil
+}
+
 // ----------------------------------------------------------------------
 // Public Methods - RevokedProperty - Setters
 // ----------------------------------------------------------------------
@@ -233,9 +288,13 @@ func (o *CommonObjectProperties) GetConfidence() int {
 // Public Methods - LangProperty - Setters
 // ----------------------------------------------------------------------
 
-// SetLang - This method takes in a string value representing an ISO 639-2
-// encoded language code as defined in RFC 5646 and updates the lang property.
+// SetLang - This method takes in a string value representing a BCP 47
+// language tag as defined in RFC 5646 and updates the lang property. If the tag
+// is not well formed an error is returned and the property is not changed.
 func (o *CommonObjectProperties) SetLang(s string) error {
+	if !IsLangValid(s) {
+		return fmt.Errorf("the lang property %q is not a valid RFC 5646 language tag", s)
+	}
 	o.Lang = s
 	return nil
 }
@@ -369,6 +428,34 @@ func (o *GranularMarking) AddSelector(s string) error {
 	return nil
 }
 
+// ----------------------------------------------------------------------
+// Public Methods - ExtensionsProperty - Setters
+// ----------------------------------------------------------------------
+
+// AddExtension - This method takes in the name of an extension and the value of
+// the extension and adds it to the extensions property. The name must be the
+// STIX identifier of an extension-definition object, one of the predefined
+// extension names like "ntfs-ext", or a deprecated custom extension name that
+// starts with x-. If the extension is already present it is replaced.
+func (o *CommonObjectProperties) AddExtension(id string, ext interface{}) error {
+	if !IsExtensionNameValid(id) {
+		return fmt.Errorf("the extension name %q is not an extension-definition identifier or a predefined extension", id)
+	}
+
+	if o.Extensions == nil {
+		o.Extensions = make(map[string]interface{})
+	}
+	o.Extensions[id] = ext
+	return nil
+}
+
+// GetExtension - This method will return the value of the extension with the
+// name given and true, or nil and false if the object does not have it.
+func (o *CommonObjectProperties) GetExtension(id string) (interface{}, bool) {
+	ext, found := o.Extensions[id]
+	return ext, found
+}
+
 // ----------------------------------------------------------------------
 // Public Methods - RawProperty - Setters
 // ----------------------------------------------------------------------
@@ -0,0 +1,138 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// TestSetCreatedByRef - Make sure only identity references are accepted and
+// that ValidSDO checks the property when it is present.
+func TestSetCreatedByRef(t *testing.T) {
+	var o CommonObjectProperties
+	o.InitSDO("indicator")
+
+	want := "identity--f431f809-377b-45e0-aa1c-6a4751cae5ff"
+	if err := o.SetCreatedByRef(want); err != nil || o.GetCreatedByRef() != want {
+		t.Errorf("Fail SetCreatedByRef did not accept an identity reference: %v", err)
+	}
+
+	if err := o.SetCreatedByRef("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f"); err == nil {
+		t.Error("Fail SetCreatedByRef should reject a reference to a non identity object")
+	}
+
+	if err := o.SetCreatedByRef("identity--bad"); err == nil {
+		t.Error("Fail SetCreatedByRef should reject a malformed identifier")
+	}
+
+	if o.GetCreatedByRef() != want {
+		t.Error("Fail a rejected value should not change the property")
+	}
+
+	if valid, _, details := o.ValidSDO(false); !valid {
+		t.Error("Fail object with a valid created_by_ref should be valid")
+		t.Log(details)
+	}
+
+	o.CreatedByRef = "tool--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f"
+	if valid, _, _ := o.ValidSDO(false); valid {
+		t.Error("Fail ValidSDO should reject a created_by_ref that is not an identity")
+	}
+}
+
+// TestAddExtension - Make sure extensions are stored under valid names only,
+// survive a JSON round trip, and are checked by ValidSDO.
+func TestAddExtension(t *testing.T) {
+	var o CommonObjectProperties
+	o.InitSDO("indicator")
+
+	id := "extension-definition--d83fce45-ef58-4c6c-a3f4-1fbc32e98c6e"
+	if err := o.AddExtension(id, map[string]interface{}{"extension_type": "property-extension", "rank": 5}); err != nil {
+		t.Fatalf("Fail AddExtension rejected an extension definition id: %v", err)
+	}
+	if err := o.AddExtension("ntfs-ext", map[string]interface{}{"sid": "1"}); err != nil {
+		t.Errorf("Fail AddExtension rejected a predefined extension: %v", err)
+	}
+	if err := o.AddExtension("my-extension", true); err == nil {
+		t.Error("Fail AddExtension should reject an unknown extension name")
+	}
+	if err := o.AddExtension("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f", true); err == nil {
+		t.Error("Fail AddExtension should reject an id that is not an extension definition")
+	}
+
+	data, _ := json.Marshal(&o)
+	var o2 CommonObjectProperties
+	json.Unmarshal(data, &o2)
+	ext, found := o2.GetExtension(id)
+	if !found || ext.(map[string]interface{})["rank"] != float64(5) {
+		t.Error("Fail extension did not survive a round trip")
+	}
+
+	if valid, _, details := o.ValidSDO(false); !valid {
+		t.Error("Fail object with valid extensions should be valid")
+		t.Log(details)
+	}
+
+	o.Extensions["bad"] = true
+	if valid, _, _ := o.ValidSDO(false); valid {
+		t.Error("Fail ValidSDO should reject an invalid extension name")
+	}
+}
+
+// TestTouch - Make sure Touch always moves the modified timestamp forward, even
+// when the existing value is in the future, and requires created to be set.
+func TestTouch(t *testing.T) {
+	var o CommonObjectProperties
+	if err := o.Touch(); err == nil {
+		t.Error("Fail Touch should require the created property")
+	}
+
+	o.SetCreatedToCurrentTime()
+	future := time.Now().UTC().Add(time.Hour)
+	o.SetModified(future)
+	before, _ := time.Parse(time.RFC3339Nano, o.Modified)
+
+	for i := 0; i < 3; i++ {
+		if err := o.Touch(); err != nil {
+			t.Fatalf("Fail Touch returned an error: %v", err)
+		}
+		after, err := time.Parse(time.RFC3339Nano, o.Modified)
+		if err != nil || !after.After(before) {
+			t.Fatalf("Fail modified did not move forward: %s", o.Modified)
+		}
+		before = after
+	}
+}
+
+// TestMarkTLP - Make sure the canonical TLP marking definitions are added once
+// and that unknown levels are rejected.
+func TestMarkTLP(t *testing.T) {
+	var o CommonObjectProperties
+
+	if err := o.MarkTLP("AMBER"); err != nil {
+		t.Fatalf("Fail MarkTLP returned an error: %v", err)
+	}
+	o.MarkTLP("amber")
+	if len(o.ObjectMarkingRefs) != 1 || o.ObjectMarkingRefs[0] != TLPAmberID {
+		t.Errorf("Fail expected a single TLP:AMBER marking, got %v", o.ObjectMarkingRefs)
+	}
+
+	if err := o.MarkTLP2("amber+strict"); err != nil {
+		t.Fatalf("Fail MarkTLP2 returned an error: %v", err)
+	}
+	if level, _ := TLPLevelForID(o.ObjectMarkingRefs[1]); level != "amber+strict" {
+		t.Errorf("Fail expected the TLP 2.0 amber+strict marking, got %s", level)
+	}
+
+	if err := o.MarkTLP("clear"); err == nil {
+		t.Error("Fail MarkTLP should reject a TLP 2.0 only level")
+	}
+	if err := o.MarkTLP2("purple"); err == nil {
+		t.Error("Fail MarkTLP2 should reject an unknown level")
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------------------------
+// Validation Severity
+// ----------------------------------------------------------------------
+
+/*
+The detailed results returned by the Valid() methods are tagged with a severity
+prefix so that callers can decide how to handle each entry:
+
+	"++ " the property was checked and is valid
+	"-- " an error, the object violates the specification and is counted as a problem
+	"** " a warning, like a value that is not in a suggested vocabulary, it is not counted
+
+Only errors are included in the problem count that Valid() returns.
+*/
+
+/*
+StrictValidation - When this is true, the *_types properties that the STIX 2.1
+specification requires, but that many real-world objects leave out, are
+treated as errors instead of warnings.
+*/
+var StrictValidation = false
+
+/*
+MissingLenientProperty - This function will return the problem count and
+detailed result for a required property that is missing, but that is only
+enforced when StrictValidation is set. It is called from the Valid() methods
+of the individual objects.
+*/
+func MissingLenientProperty(propertyName string) (int, string) {
+	if StrictValidation {
+		return 1, fmt.Sprintf("-- The %s property is required but missing", propertyName)
+	}
+	return 0, fmt.Sprintf("** The %s property is required but missing", propertyName)
+}
+
+/*
+ValidationErrors - This function will return just the errors from the detailed
+results of a Valid() method.
+*/
+func ValidationErrors(details []string) []string {
+	return detailsWithPrefix(details, "--")
+}
+
+/*
+ValidationWarnings - This function will return just the warnings from the
+detailed results of a Valid() method.
+*/
+func ValidationWarnings(details []string) []string {
+	return detailsWithPrefix(details, "**")
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+func detailsWithPrefix(details []string, prefix string) []string {
+	out := make([]string, 0)
+	for _, v := range details {
+		if strings.HasPrefix(v, prefix) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"testing"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestMissingLenientProperty - Make sure a missing lenient property is only
+counted as a problem when StrictValidation is set.
+*/
+func TestMissingLenientProperty(t *testing.T) {
+	defer func() { StrictValidation = false }()
+
+	if n, str := MissingLenientProperty("tool_types"); n != 0 || str[:3] != "** " {
+		t.Errorf("Fail expected an uncounted warning, got %d %q", n, str)
+	}
+
+	StrictValidation = true
+	if n, str := MissingLenientProperty("tool_types"); n != 1 || str[:3] != "-- " {
+		t.Errorf("Fail expected a counted error in strict mode, got %d %q", n, str)
+	}
+}
+
+/*
+TestValidationErrorsAndWarnings - Make sure the detailed results are split by
+their severity prefix.
+*/
+func TestValidationErrorsAndWarnings(t *testing.T) {
+	details := []string{
+		"++ the id property is required and is found",
+		"-- the created property is required but missing",
+		"** The tool type 'foo' is not in the allowed vocabulary",
+	}
+
+	if got := ValidationErrors(details); len(got) != 1 || got[0] != details[1] {
+		t.Errorf("Fail ValidationErrors returned %v", got)
+	}
+
+	if got := ValidationWarnings(details); len(got) != 1 || got[0] != details[2] {
+		t.Errorf("Fail ValidationWarnings returned %v", got)
+	}
+}
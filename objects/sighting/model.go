@@ -6,7 +6,10 @@
 package sighting
 
 import (
+	"time"
+
 	"github.com/freetaxii/libstix2/objects"
+	"github.com/freetaxii/libstix2/objects/observeddata"
 )
 
 // ----------------------------------------------------------------------
@@ -22,6 +25,7 @@ properties.
 type Sighting struct {
 	objects.CommonObjectProperties
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	objects.SeenProperties
 	Count            int      `json:"count,omitempty" bson:"count,omitempty"`
 	SightingOfRef    string   `json:"sighting_of_ref,omitempty" bson:"sighting_of_ref,omitempty"`
@@ -36,7 +40,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Sighting) GetPropertyList() []string {
-	return []string{"description", "first_seen", "last_seen", "count", "sighting_of_ref", "observed_data_refs", "where_sighted_refs", "summary"}
+	return []string{"description", "x_descriptions", "first_seen", "last_seen", "count", "sighting_of_ref", "observed_data_refs", "where_sighted_refs", "summary"}
 }
 
 // ----------------------------------------------------------------------
@@ -53,3 +57,55 @@ func New() *Sighting {
 	obj.InitSRO("sighting")
 	return &obj
 }
+
+/*
+FromObservedData - This function will create a new STIX Sighting object of the
+indicator given, built from the observed data objects, and return it as a
+pointer. The observed data refs are set to the ids of the observed data
+objects, the count is the sum of their number_observed values, and first_seen
+and last_seen cover the earliest first_observed and the latest last_observed.
+If whereSighted is not empty it is added to the where sighted refs property.
+*/
+func FromObservedData(indicatorID string, observed []*observeddata.ObservedData, whereSighted string) *Sighting {
+	obj := New()
+	obj.SetSightingOfRef(indicatorID)
+
+	if whereSighted != "" {
+		obj.WhereSightedRefs = append(obj.WhereSightedRefs, whereSighted)
+	}
+
+	var first, last time.Time
+	for _, v := range observed {
+		if v == nil {
+			continue
+		}
+
+		obj.ObservedDataRefs = append(obj.ObservedDataRefs, v.ID)
+		obj.Count += v.NumberObserved
+
+		if t, err := time.Parse(time.RFC3339, v.FirstObserved); err == nil {
+			if first.IsZero() || t.Before(first) {
+				first = t
+				obj.FirstSeen = v.FirstObserved
+			}
+		}
+
+		if t, err := time.Parse(time.RFC3339, v.LastObserved); err == nil {
+			if last.IsZero() || t.After(last) {
+				last = t
+				obj.LastSeen = v.LastObserved
+			}
+		}
+	}
+
+	return obj
+}
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Sighting) Clone() *Sighting {
+	return objects.DeepCopy(o).(*Sighting)
+}
@@ -0,0 +1,44 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package sighting
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/observeddata"
+)
+
+// TestFromObservedData - Make sure the sighting refs, count, and seen window
+// are built from the observed data objects.
+func TestFromObservedData(t *testing.T) {
+	od1 := observeddata.New()
+	od1.FirstObserved = "2015-12-21T19:00:00Z"
+	od1.LastObserved = "2015-12-21T19:30:00Z"
+	od1.NumberObserved = 5
+
+	od2 := observeddata.New()
+	od2.FirstObserved = "2015-12-21T18:00:00.000Z"
+	od2.LastObserved = "2015-12-21T20:00:00.000Z"
+	od2.NumberObserved = 2
+
+	s := FromObservedData("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f", []*observeddata.ObservedData{od1, od2}, "identity--f431f809-377b-45e0-aa1c-6a4751cae5ff")
+
+	if s.SightingOfRef != "indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f" {
+		t.Error("Fail sighting_of_ref was not set")
+	}
+	if len(s.ObservedDataRefs) != 2 || s.ObservedDataRefs[1] != od2.ID {
+		t.Error("Fail observed_data_refs were not set")
+	}
+	if len(s.WhereSightedRefs) != 1 {
+		t.Error("Fail where_sighted_refs was not set")
+	}
+	if s.Count != 7 {
+		t.Errorf("Fail count should be 7, got %d", s.Count)
+	}
+	if s.FirstSeen != od2.FirstObserved || s.LastSeen != od2.LastObserved {
+		t.Errorf("Fail seen window is wrong: %s %s", s.FirstSeen, s.LastSeen)
+	}
+}
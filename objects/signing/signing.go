@@ -0,0 +1,112 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+/*
+Package signing signs and verifies STIX objects with Ed25519 keys.
+
+The signature is computed over the canonical JSON form of the object, see
+objects.CanonicalJSON(), and is stored on the object as a base64 encoded
+x_signature property, see objects.CommonObjectProperties.Signature. The x_signature property itself is never part of
+the signed bytes, so an object can be verified after it has been signed.
+*/
+package signing
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// SignatureProperty - This is the name of the property that holds the
+// signature of an object.
+const SignatureProperty = "x_signature"
+
+// ----------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------
+
+/*
+Sign - This function will sign the canonical form of a STIX object with the
+private key, store the signature in the x_signature property, and
+return the signature as a base64 encoded string. Any existing signature is
+replaced.
+*/
+func Sign(obj objects.STIXObject, priv ed25519.PrivateKey) (string, error) {
+	if len(priv) != ed25519.PrivateKeySize {
+		return "", errors.New("the private key is not a valid ed25519 private key")
+	}
+
+	data, err := signedBytes(obj)
+	if err != nil {
+		return "", err
+	}
+
+	sig := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, data))
+	obj.GetCommonProperties().Signature = sig
+
+	return sig, nil
+}
+
+/*
+Verify - This function will verify that sig is a valid signature of the
+canonical form of a STIX object for the public key. It returns false if the
+object has been changed since it was signed.
+*/
+func Verify(obj objects.STIXObject, sig string, pub ed25519.PublicKey) (bool, error) {
+	if len(pub) != ed25519.PublicKeySize {
+		return false, errors.New("the public key is not a valid ed25519 public key")
+	}
+
+	rawSig, err := base64.StdEncoding.DecodeString(sig)
+	if err != nil {
+		return false, err
+	}
+
+	data, err := signedBytes(obj)
+	if err != nil {
+		return false, err
+	}
+
+	return ed25519.Verify(pub, data, rawSig), nil
+}
+
+/*
+GetSignature - This function will return the signature that is stored in the
+x_signature property of a STIX object, if there is one.
+*/
+func GetSignature(obj objects.STIXObject) (string, bool) {
+	sig := obj.GetCommonProperties().Signature
+	return sig, sig != ""
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+signedBytes - This function returns the canonical JSON of the object with the
+x_signature property removed.
+*/
+func signedBytes(obj objects.STIXObject) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	delete(m, SignatureProperty)
+
+	data, err = json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	return objects.CanonicalizeJSON(data)
+}
@@ -0,0 +1,98 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package signing
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// TestSignAndVerify - Make sure a signed object verifies, and that it no longer
+// verifies once it has been changed.
+func TestSignAndVerify(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := indicator.New()
+	i.SetName("Malicious site")
+	i.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+
+	sig, err := Sign(i, priv)
+	if err != nil {
+		t.Fatalf("Fail unable to sign object: %v", err)
+	}
+
+	if stored, found := GetSignature(i); !found || stored != sig {
+		t.Error("Fail signature was not stored in x_signature")
+	}
+
+	if ok, err := Verify(i, sig, pub); !ok || err != nil {
+		t.Errorf("Fail signed object did not verify: %v", err)
+	}
+
+	i.SetName("Benign site")
+	if ok, _ := Verify(i, sig, pub); ok {
+		t.Error("Fail changed object should not verify")
+	}
+}
+
+// TestVerifyBadKey - Make sure an invalid public key is reported.
+func TestVerifyBadKey(t *testing.T) {
+	if _, err := Verify(indicator.New(), "", ed25519.PublicKey("short")); err == nil {
+		t.Error("Fail Verify should reject an invalid public key")
+	}
+}
+
+// TestVerifyAfterDecode - Make sure the signature is a top level x_signature
+// property and that the object still verifies after it is encoded and decoded.
+func TestVerifyAfterDecode(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := indicator.New()
+	i.SetName("Malicious site")
+	i.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+
+	sig, err := Sign(i, priv)
+	if err != nil {
+		t.Fatalf("Fail unable to sign object: %v", err)
+	}
+
+	data, err := i.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var m map[string]interface{}
+	json.Unmarshal(data, &m)
+	if m[SignatureProperty] != sig {
+		t.Errorf("Fail expected a top level x_signature property, got %s", data)
+	}
+
+	decoded, err := indicator.Decode(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stored, found := GetSignature(decoded)
+	if !found || stored != sig {
+		t.Fatal("Fail x_signature was lost when the object was decoded")
+	}
+	if len(decoded.Custom) != 0 {
+		t.Errorf("Fail x_signature should not be a custom property, got %v", decoded.Custom)
+	}
+
+	if ok, err := Verify(decoded, stored, pub); !ok || err != nil {
+		t.Errorf("Fail decoded object did not verify: %v", err)
+	}
+}
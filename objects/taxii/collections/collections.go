@@ -6,6 +6,10 @@
 package collections
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/freetaxii/libstix2/defs"
 	"github.com/freetaxii/libstix2/objects"
 	"github.com/freetaxii/libstix2/objects/taxii/envelope"
 	"github.com/freetaxii/libstix2/objects/taxii/manifest"
@@ -54,6 +58,7 @@ Description = A long description about this collection
 CanRead     = A boolean flag that indicates if one can read from this collection
 CanWrite    = A boolean flag that indicates if one can write to this collection
 MediaTypes  = A slice of strings of the media types that are found in this collection
+SpecVersions = A slice of strings of the STIX versions that are found in this collection
 
 The following information comes directly from the TAXII 2 specification documents.
 
@@ -77,9 +82,10 @@ type Collection struct {
 	objects.IDProperty
 	objects.TitleProperty
 	objects.DescriptionProperty
-	CanRead    bool     `json:"can_read"`
-	CanWrite   bool     `json:"can_write"`
-	MediaTypes []string `json:"media_types,omitempty"`
+	CanRead      bool     `json:"can_read"`
+	CanWrite     bool     `json:"can_write"`
+	MediaTypes   []string `json:"media_types,omitempty"`
+	SpecVersions []string `json:"spec_versions,omitempty"`
 }
 
 /*
@@ -127,6 +133,16 @@ type CollectionQueryResult struct {
 	// RangeEnd       int
 }
 
+/*
+validMediaTypes - These are the media types that a collection can advertise
+for the objects that can be requested from or added to it.
+*/
+var validMediaTypes = map[string]bool{
+	defs.MEDIA_TYPE_STIX:   true,
+	defs.MEDIA_TYPE_STIX20: true,
+	defs.MEDIA_TYPE_STIX21: true,
+}
+
 // ----------------------------------------------------------------------
 // Initialization Functions
 // ----------------------------------------------------------------------
@@ -192,6 +208,27 @@ func (o *Collections) NewCollection() (*Collection, error) {
 	return &o.Collections[positionThatAppendWillUse], nil
 }
 
+/*
+GetCollectionsForAccess - This method will return a new collections resource
+that only has the enabled and visible collections that a caller with the access
+given can use. A collection is included if canRead is true and the collection
+can be read from, or if canWrite is true and the collection can be written to.
+This lets a server show a write only collection only to the callers that are
+allowed to upload to it.
+*/
+func (o *Collections) GetCollectionsForAccess(canRead, canWrite bool) *Collections {
+	c := New()
+	for _, v := range o.Collections {
+		if !v.Enabled || v.Hidden {
+			continue
+		}
+		if (canRead && v.CanRead) || (canWrite && v.CanWrite) {
+			c.AddCollection(&v)
+		}
+	}
+	return c
+}
+
 // ----------------------------------------------------------------------
 // Private Methods - Collections
 // ----------------------------------------------------------------------
@@ -287,3 +324,95 @@ func (o *Collection) AddMediaType(s string) error {
 	o.MediaTypes = append(o.MediaTypes, s)
 	return nil
 }
+
+/*
+SetMediaTypes - This method takes in a slice of media types and replaces the
+list in the media types property. Each media type must be one of the known
+STIX media types, if any of them are not, an error is returned and the
+property is left unchanged.
+*/
+func (o *Collection) SetMediaTypes(types []string) error {
+	a := make([]string, 0, len(types))
+	for _, v := range types {
+		if v == "" {
+			return errors.New("the media type can not be empty")
+		}
+		if !validMediaTypes[v] {
+			return fmt.Errorf("the media type %q is not a known STIX media type", v)
+		}
+		a = append(a, v)
+	}
+	o.MediaTypes = a
+	return nil
+}
+
+/*
+GetMediaTypes - This method will return the media types that are found in
+this collection.
+*/
+func (o *Collection) GetMediaTypes() []string {
+	return o.MediaTypes
+}
+
+/*
+AddSpecVersion - This method takes in a string value that represents a version
+of the STIX specification, like "2.1", that is found in this collection and
+adds it to the list in the spec versions property. Versions that are already
+in the list are not added again.
+*/
+func (o *Collection) AddSpecVersion(s string) error {
+	if s != "2.0" && s != "2.1" {
+		return fmt.Errorf("the spec version %q is not a supported STIX version", s)
+	}
+
+	if o.HasSpecVersion(s) {
+		return nil
+	}
+
+	if o.SpecVersions == nil {
+		a := make([]string, 0)
+		o.SpecVersions = a
+	}
+	o.SpecVersions = append(o.SpecVersions, s)
+	return nil
+}
+
+/*
+GetSpecVersions - This method will return the STIX versions that are found in
+this collection.
+*/
+func (o *Collection) GetSpecVersions() []string {
+	return o.SpecVersions
+}
+
+/*
+HasSpecVersion - This method will return true if the collection advertises the
+STIX version given. Clients can use this to decide if they want to poll the
+collection.
+*/
+func (o *Collection) HasSpecVersion(s string) bool {
+	for _, v := range o.SpecVersions {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+AddSpecVersionsFromObjects - This method will add the spec version of each of
+the objects to the list in the spec versions property. Objects without a spec
+version are STIX 2.0 objects, as STIX 2.0 only carried it on the bundle.
+*/
+func (o *Collection) AddSpecVersionsFromObjects(objs []objects.STIXObject) error {
+	for _, v := range objs {
+		ver := v.GetCommonProperties().GetSpecVersion()
+		if ver == "" {
+			ver = "2.0"
+		}
+		if err := o.AddSpecVersion(ver); err != nil {
+			return err
+		}
+	}
+	return nil
+}
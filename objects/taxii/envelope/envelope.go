@@ -28,6 +28,7 @@ outside of STIX content.
 */
 type Envelope struct {
 	More    bool          `json:"more,omitempty"`
+	Next    string        `json:"next,omitempty"`
 	Objects []interface{} `json:"objects,omitempty"`
 }
 
@@ -37,6 +38,7 @@ Objects property needs special handling.
 */
 type EnvelopeRawDecode struct {
 	More    bool              `json:"more,omitempty"`
+	Next    string            `json:"next,omitempty"`
 	Objects []json.RawMessage `json:"objects,omitempty"`
 }
 
@@ -131,3 +133,19 @@ func (o *Envelope) SetMore() error {
 	o.More = true
 	return nil
 }
+
+/*
+GetNext - This method will return the next property
+*/
+func (o *Envelope) GetNext() string {
+	return o.Next
+}
+
+/*
+SetNext - This method will set the next property, which is the value a client
+passes in the next URL parameter to get the next page of results.
+*/
+func (o *Envelope) SetNext(s string) error {
+	o.Next = s
+	return nil
+}
@@ -6,6 +6,11 @@
 package manifest
 
 import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/freetaxii/libstix2/defs"
 	"github.com/freetaxii/libstix2/objects"
 )
 
@@ -81,6 +86,26 @@ func NewRecord() *ManifestRecord {
 	return &obj
 }
 
+// ----------------------------------------------------------------------
+// Public Functions
+// ----------------------------------------------------------------------
+
+/*
+MediaTypeForSpecVersion - This function will take in a STIX specification
+version, like "2.1", and return the media type that a manifest record uses for
+an object of that version. An empty version is treated as STIX 2.0, since STIX
+2.0 objects do not carry a spec_version.
+*/
+func MediaTypeForSpecVersion(s string) (string, error) {
+	switch s {
+	case "2.1":
+		return defs.MEDIA_TYPE_STIX21, nil
+	case "2.0", "":
+		return defs.MEDIA_TYPE_STIX20, nil
+	}
+	return "", fmt.Errorf("the spec version %q does not have a known media type", s)
+}
+
 // ----------------------------------------------------------------------
 // Public Methods - Manifest
 // ----------------------------------------------------------------------
@@ -123,6 +148,19 @@ func (o *Manifest) CreateRecord(id, date, ver, media string) error {
 	return nil
 }
 
+/*
+CreateRecordForSpecVersion - This method is used to create and add a manifest
+entry in a single step, like CreateRecord(), but takes in the STIX spec version
+of the object and sets the media type from it.
+*/
+func (o *Manifest) CreateRecordForSpecVersion(id, date, ver, specVersion string) error {
+	media, err := MediaTypeForSpecVersion(specVersion)
+	if err != nil {
+		return err
+	}
+	return o.CreateRecord(id, date, ver, media)
+}
+
 /*
 GetMore - This method will return the more property
 */
@@ -138,6 +176,29 @@ func (o *Manifest) SetMore() error {
 	return nil
 }
 
+/*
+ToCSV - This method will write the manifest records to w as CSV. The first row
+is a header row and each manifest record is written as its own row with the
+id, date_added, version, and media_type columns. Values that contain commas or
+quotes are quoted as needed.
+*/
+func (o *Manifest) ToCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"id", "date_added", "version", "media_type"}); err != nil {
+		return err
+	}
+
+	for _, v := range o.Objects {
+		if err := cw.Write([]string{v.ID, v.DateAdded, v.Version, v.MediaType}); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
 // ----------------------------------------------------------------------
 // Public Methods - ManifestRecord
 // ----------------------------------------------------------------------
@@ -165,3 +226,15 @@ func (o *ManifestRecord) SetMediaType(s string) error {
 	o.MediaType = s
 	return nil
 }
+
+/*
+SetMediaTypeFromSpecVersion - This method will set the media type of the
+manifest entry to the one that matches the STIX spec version given.
+*/
+func (o *ManifestRecord) SetMediaTypeFromSpecVersion(s string) error {
+	media, err := MediaTypeForSpecVersion(s)
+	if err != nil {
+		return err
+	}
+	return o.SetMediaType(media)
+}
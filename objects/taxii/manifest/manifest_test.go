@@ -0,0 +1,46 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestToCSV - Make sure the header row is written first and that a value with a
+comma or a quote in it is quoted so the CSV can be read back.
+*/
+func TestToCSV(t *testing.T) {
+	m := New()
+	m.CreateRecord("indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f", "2016-11-01T03:04:05Z", "2016-11-03T12:30:59.000Z", "application/stix+json;version=2.1")
+	m.CreateRecord("x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b", "2016-11-01T03:04:05Z", "2016-11-03T12:30:59.000Z", `application/x-acme;profile="a,b"`)
+
+	var buf bytes.Buffer
+	if err := m.ToCSV(&buf); err != nil {
+		t.Fatalf("Fail ToCSV returned an error: %v", err)
+	}
+
+	want := "id,date_added,version,media_type\n" +
+		"indicator--8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f,2016-11-01T03:04:05Z,2016-11-03T12:30:59.000Z,application/stix+json;version=2.1\n" +
+		`x-acme-widget--31b940d4-6f7f-459a-80ea-9c1f17b5891b,2016-11-01T03:04:05Z,2016-11-03T12:30:59.000Z,"application/x-acme;profile=""a,b"""` + "\n"
+	if buf.String() != want {
+		t.Errorf("Fail unexpected CSV\n got: %s\nwant: %s", buf.String(), want)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Fail the CSV could not be read back: %v", err)
+	}
+	if len(records) != 3 || records[2][3] != `application/x-acme;profile="a,b"` {
+		t.Errorf("Fail the quoted value did not read back as written: %v", records)
+	}
+}
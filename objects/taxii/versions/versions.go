@@ -5,6 +5,8 @@
 
 package versions
 
+import "time"
+
 // ----------------------------------------------------------------------
 // Define Message Type
 // ----------------------------------------------------------------------
@@ -71,3 +73,53 @@ func (o *Versions) SetMore() error {
 	o.More = true
 	return nil
 }
+
+/*
+GetVersions - This method will return the list of versions
+*/
+func (o *Versions) GetVersions() []string {
+	return o.Versions
+}
+
+/*
+Latest - This method will return the most recent version in the list of
+versions or an empty string if there are no versions. Versions are the
+modified timestamps of an object, so they are compared as times.
+*/
+func (o *Versions) Latest() string {
+	return o.pick(func(a, b time.Time) bool { return a.After(b) })
+}
+
+/*
+Earliest - This method will return the oldest version in the list of versions
+or an empty string if there are no versions.
+*/
+func (o *Versions) Earliest() string {
+	return o.pick(func(a, b time.Time) bool { return a.Before(b) })
+}
+
+// ----------------------------------------------------------------------
+// Private Methods
+// ----------------------------------------------------------------------
+
+/*
+pick - This method will walk the list of versions and return the one that
+wins the comparison. Versions that can not be parsed as a timestamp are
+skipped.
+*/
+func (o *Versions) pick(better func(a, b time.Time) bool) string {
+	var found string
+	var foundTime time.Time
+
+	for _, v := range o.Versions {
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			continue
+		}
+		if found == "" || better(t, foundTime) {
+			found = v
+			foundTime = t
+		}
+	}
+	return found
+}
@@ -23,6 +23,7 @@ type ThreatActor struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	ThreatActorTypes []string `json:"threat_actor_types,omitempty"`
 	objects.AliasesProperty
 	objects.SeenProperties
@@ -40,7 +41,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *ThreatActor) GetPropertyList() []string {
-	return []string{"name", "description", "threat_actor_types", "aliases", "first_seen", "last_seen", "roles", "goals", "sophistication", "resource_level", "primary_motivation", "secondary_motivations", "personal_motivations"}
+	return []string{"name", "description", "x_descriptions", "threat_actor_types", "aliases", "first_seen", "last_seen", "roles", "goals", "sophistication", "resource_level", "primary_motivation", "secondary_motivations", "personal_motivations"}
 }
 
 // ----------------------------------------------------------------------
@@ -57,3 +58,12 @@ func New() *ThreatActor {
 	obj.InitSDO("threat-actor")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *ThreatActor) Clone() *ThreatActor {
+	return objects.DeepCopy(o).(*ThreatActor)
+}
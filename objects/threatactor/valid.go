@@ -5,7 +5,11 @@
 
 package threatactor
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/freetaxii/libstix2/objects"
+)
 
 // ----------------------------------------------------------------------
 // Public Methods
@@ -31,12 +35,17 @@ func (o *ThreatActor) Valid(debug bool) (bool, int, []string) {
 	// problemsFound += pName
 	// resultDetails = append(resultDetails, dName...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	// Verify threat actor types is present
 	if len(o.ThreatActorTypes) == 0 {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The threat_actor_types property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("threat_actor_types")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		str := fmt.Sprintf("++ The threat_actor_types property is required and is present")
@@ -0,0 +1,115 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ----------------------------------------------------------------------
+// Define TLP Markings
+// ----------------------------------------------------------------------
+
+// These are the ids of the canonical TLP 1.0 marking definitions that are
+// defined in the STIX 2.1 specification.
+const (
+	TLPWhiteID = "marking-definition--613f2e26-407d-48c7-9eca-b8e91df99dc9"
+	TLPGreenID = "marking-definition--34098fce-860f-48ae-8e50-ebd3cc5e41da"
+	TLPAmberID = "marking-definition--f88d31f6-486f-44da-b317-01333bde0b82"
+	TLPRedID   = "marking-definition--5e57c739-391a-4eb3-b6be-7d15ca92d5ed"
+)
+
+// These are the ids of the TLP 2.0 marking definitions that are published by
+// OASIS with the TLP 2.0 extension definition.
+const (
+	TLP2ClearID       = "marking-definition--94868c89-83c2-464b-929b-a1a8aa3c8487"
+	TLP2GreenID       = "marking-definition--bab4a63c-aed9-4cf5-a766-dfca5abac2bb"
+	TLP2AmberID       = "marking-definition--55d920b0-5e8b-4f79-9ee9-91f868d9b421"
+	TLP2AmberStrictID = "marking-definition--939a9414-2ddd-4d32-a0cd-375ea402b003"
+	TLP2RedID         = "marking-definition--e828b379-4e03-4974-9ac4-e53a884c97c1"
+)
+
+var tlp1IDs = map[string]string{
+	"white": TLPWhiteID,
+	"green": TLPGreenID,
+	"amber": TLPAmberID,
+	"red":   TLPRedID,
+}
+
+var tlp2IDs = map[string]string{
+	"clear":        TLP2ClearID,
+	"green":        TLP2GreenID,
+	"amber":        TLP2AmberID,
+	"amber+strict": TLP2AmberStrictID,
+	"red":          TLP2RedID,
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - TLP
+// ----------------------------------------------------------------------
+
+// TLPLevelForID - This function will take in the id of a marking definition and
+// return its TLP level, if it is one of the canonical TLP 1.0 or TLP 2.0
+// marking definitions.
+func TLPLevelForID(id string) (string, bool) {
+	for level, v := range tlp1IDs {
+		if v == id {
+			return level, true
+		}
+	}
+	for level, v := range tlp2IDs {
+		if v == id {
+			return level, true
+		}
+	}
+	return "", false
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - MarkingProperty - TLP
+// ----------------------------------------------------------------------
+
+// MarkTLP - This method takes in a TLP 1.0 level, one of white, green, amber,
+// or red, and adds the canonical STIX 2.1 marking definition for it to the
+// object marking refs. The level is not case sensitive and a marking that is
+// already on the object is not added again.
+func (o *CommonObjectProperties) MarkTLP(level string) error {
+	id, found := tlp1IDs[strings.ToLower(strings.TrimSpace(level))]
+	if !found {
+		return fmt.Errorf("the TLP level %q is not one of white, green, amber, or red", level)
+	}
+	o.addObjectMarkingRefOnce(id)
+	return nil
+}
+
+// MarkTLP2 - This method takes in a TLP 2.0 level, one of clear, green, amber,
+// amber+strict, or red, and adds the OASIS TLP 2.0 marking definition for it
+// to the object marking refs. The level is not case sensitive and a marking
+// that is already on the object is not added again.
+func (o *CommonObjectProperties) MarkTLP2(level string) error {
+	id, found := tlp2IDs[strings.ToLower(strings.TrimSpace(level))]
+	if !found {
+		return fmt.Errorf("the TLP 2.0 level %q is not one of clear, green, amber, amber+strict, or red", level)
+	}
+	o.addObjectMarkingRefOnce(id)
+	return nil
+}
+
+// ----------------------------------------------------------------------
+// Private Methods
+// ----------------------------------------------------------------------
+
+// addObjectMarkingRefOnce - This method will add the marking ref to the object
+// marking refs if it is not already there.
+func (o *CommonObjectProperties) addObjectMarkingRefOnce(id string) {
+	for _, v := range o.ObjectMarkingRefs {
+		if v == id {
+			return
+		}
+	}
+	o.ObjectMarkingRefs = append(o.ObjectMarkingRefs, id)
+}
@@ -23,6 +23,7 @@ type Tool struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 	ToolTypes []string `json:"tool_types,omitempty"`
 	objects.AliasesProperty
 	objects.KillChainPhasesProperty
@@ -35,7 +36,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Tool) GetPropertyList() []string {
-	return []string{"name", "description", "tool_types", "aliases", "kill_chain_phases", "tool_version"}
+	return []string{"name", "description", "x_descriptions", "tool_types", "aliases", "kill_chain_phases", "tool_version"}
 }
 
 // ----------------------------------------------------------------------
@@ -52,3 +53,12 @@ func New() *Tool {
 	obj.InitSDO("tool")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Tool) Clone() *Tool {
+	return objects.DeepCopy(o).(*Tool)
+}
@@ -8,6 +8,7 @@ package tool
 import (
 	"fmt"
 
+	"github.com/freetaxii/libstix2/objects"
 	"github.com/freetaxii/libstix2/vocabs"
 )
 
@@ -35,12 +36,17 @@ func (o *Tool) Valid(debug bool) (bool, int, []string) {
 	// problemsFound += pName
 	// resultDetails = append(resultDetails, dName...)
 
+	// Verify object Name property is not too long
+	_, pName, dName := o.NameProperty.Valid(debug)
+	problemsFound += pName
+	resultDetails = append(resultDetails, dName...)
+
 	// Verify tool types is present
 	if len(o.ToolTypes) == 0 {
-		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields.
-		// TODO: can make this into a "strict" validation mechanism
-		// problemsFound++
-		str := fmt.Sprintf("-- The tool_types property is required but missing")
+		// in the STIX 2.1 definition, these are required, but many real-world objects do not contain these fields,
+		// so this is only an error when objects.StrictValidation is set.
+		n, str := objects.MissingLenientProperty("tool_types")
+		problemsFound += n
 		resultDetails = append(resultDetails, str)
 	} else {
 		str := fmt.Sprintf("++ The tool_types property is required and is present")
@@ -52,7 +58,7 @@ func (o *Tool) Valid(debug bool) (bool, int, []string) {
 			if !validVocab[toolType] {
 				// this is a SHOULD not a MUST so we won't add it as a problem
 				// problemsFound++
-				str := fmt.Sprintf("-- The tool type '%s' is not in the allowed vocabulary", toolType)
+				str := fmt.Sprintf("** The tool type '%s' is not in the allowed vocabulary", toolType)
 				resultDetails = append(resultDetails, str)
 			}
 		}
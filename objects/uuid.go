@@ -0,0 +1,117 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// ----------------------------------------------------------------------
+// Define Variables
+// ----------------------------------------------------------------------
+
+// OASISNamespace - This is the UUIDv5 namespace that the STIX 2.1
+// specification defines for the deterministic identifiers of STIX Cyber
+// Observable Objects.
+var OASISNamespace = uuid.MustParse("00abedb4-aa42-466c-9c01-fed23315a9b7")
+
+var (
+	idMu        sync.RWMutex
+	idNamespace = OASISNamespace
+	idVersion   = 4
+)
+
+// ----------------------------------------------------------------------
+// Public Functions - Identifier Generation
+// ----------------------------------------------------------------------
+
+// SetIDNamespace - This function will set the UUIDv5 namespace that is used to
+// create deterministic identifiers for STIX Cyber Observable Objects. The
+// default is the OASIS namespace, organizations that agree on a different
+// namespace will produce the same identifiers for the same observables.
+func SetIDNamespace(ns uuid.UUID) error {
+	if ns == uuid.Nil {
+		return errors.New("the id namespace can not be the nil uuid")
+	}
+
+	idMu.Lock()
+	defer idMu.Unlock()
+	idNamespace = ns
+	return nil
+}
+
+// GetIDNamespace - This function will return the UUIDv5 namespace that is used
+// to create deterministic identifiers.
+func GetIDNamespace() uuid.UUID {
+	idMu.RLock()
+	defer idMu.RUnlock()
+	return idNamespace
+}
+
+// SetIDVersion - This function will set the UUID version that is used when a
+// new random identifier is created, like when New() is called on an SDO or
+// SRO. The default is 4, version 7 can be used to get identifiers that sort by
+// the time they were created.
+func SetIDVersion(v int) error {
+	if v != 4 && v != 7 {
+		return fmt.Errorf("the uuid version %d is not supported, it must be 4 or 7", v)
+	}
+
+	idMu.Lock()
+	defer idMu.Unlock()
+	idVersion = v
+	return nil
+}
+
+// GetIDVersion - This function will return the UUID version that is used when
+// a new random identifier is created.
+func GetIDVersion() int {
+	idMu.RLock()
+	defer idMu.RUnlock()
+	return idVersion
+}
+
+// CreateDeterministicSTIXUUID - This function takes in a STIX object type and
+// the contributing properties of a STIX Cyber Observable Object and returns a
+// UUIDv5 based identifier in the id namespace. The contributing properties are
+// serialized as canonical JSON, so the same values always give the same id.
+func CreateDeterministicSTIXUUID(objectType string, contributing map[string]interface{}) (string, error) {
+	if len(contributing) == 0 {
+		return "", errors.New("at least one contributing property is required")
+	}
+
+	data, err := json.Marshal(contributing)
+	if err != nil {
+		return "", err
+	}
+
+	name, err := CanonicalizeJSON(data)
+	if err != nil {
+		return "", err
+	}
+
+	return objectType + "--" + uuid.NewSHA1(GetIDNamespace(), name).String(), nil
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+// newUUID - This function will return a new random UUID of the configured
+// version as a string.
+func newUUID() string {
+	if GetIDVersion() == 7 {
+		if u, err := uuid.NewV7(); err == nil {
+			return u.String()
+		}
+	}
+	return uuid.New().String()
+}
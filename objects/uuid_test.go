@@ -0,0 +1,79 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package objects
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestCreateDeterministicSTIXUUID - Make sure the same contributing properties
+give the same id, and that changing the namespace changes the id.
+*/
+func TestCreateDeterministicSTIXUUID(t *testing.T) {
+	defer SetIDNamespace(OASISNamespace)
+
+	props := map[string]interface{}{"value": "example.com"}
+	id1, err := CreateDeterministicSTIXUUID("domain-name", props)
+	if err != nil {
+		t.Fatalf("Fail CreateDeterministicSTIXUUID returned an error: %v", err)
+	}
+	id2, _ := CreateDeterministicSTIXUUID("domain-name", map[string]interface{}{"value": "example.com"})
+	if id1 != id2 {
+		t.Errorf("Fail the same properties gave different ids: %s | %s", id1, id2)
+	}
+
+	_, u, err := ParseID(id1)
+	if err != nil || uuid.MustParse(u).Version() != 5 {
+		t.Errorf("Fail expected a valid UUIDv5 id, got %s", id1)
+	}
+
+	if err := SetIDNamespace(uuid.New()); err != nil {
+		t.Fatalf("Fail SetIDNamespace returned an error: %v", err)
+	}
+	if id3, _ := CreateDeterministicSTIXUUID("domain-name", props); id3 == id1 {
+		t.Error("Fail changing the namespace should change the id")
+	}
+
+	if err := SetIDNamespace(uuid.Nil); err == nil {
+		t.Error("Fail SetIDNamespace should reject the nil uuid")
+	}
+
+	if _, err := CreateDeterministicSTIXUUID("domain-name", nil); err == nil {
+		t.Error("Fail CreateDeterministicSTIXUUID should require contributing properties")
+	}
+}
+
+/*
+TestSetIDVersion - Make sure new ids use the configured UUID version.
+*/
+func TestSetIDVersion(t *testing.T) {
+	defer SetIDVersion(4)
+
+	var o CommonObjectProperties
+	id, _ := o.CreateSTIXUUID("indicator")
+	if _, u, _ := ParseID(id); uuid.MustParse(u).Version() != 4 {
+		t.Errorf("Fail expected a UUIDv4 id by default, got %s", id)
+	}
+
+	if err := SetIDVersion(7); err != nil {
+		t.Fatalf("Fail SetIDVersion returned an error: %v", err)
+	}
+	id, _ = o.CreateSTIXUUID("indicator")
+	if _, u, _ := ParseID(id); uuid.MustParse(u).Version() != 7 {
+		t.Errorf("Fail expected a UUIDv7 id, got %s", id)
+	}
+
+	if err := SetIDVersion(1); err == nil {
+		t.Error("Fail SetIDVersion should reject unsupported versions")
+	}
+}
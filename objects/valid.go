@@ -7,7 +7,6 @@ package objects
 
 import (
 	"fmt"
-	"strings"
 	"time"
 )
 
@@ -39,6 +38,8 @@ func (o *CommonObjectProperties) ValidSDOWithExclusions(debug bool, excludedFiel
 	o.checkCreatedByRefWithExclusions(r, excludedFields)
 	o.checkCreatedWithExclusions(r, excludedFields)
 	o.checkModifiedWithExclusions(r, excludedFields)
+	o.checkLang(r)
+	o.checkExtensions(r)
 
 	// Return real values not pointers
 	if r.problemsFound > 0 {
@@ -108,47 +109,22 @@ func isObjectTypeValid(s string) bool {
 }
 
 // IsIDValid - This function will take in an ID and check to see if it is
-// a valid identifier per the specification for a STIX object.
+// a valid identifier per the specification. See ParseID() for the rules.
 func IsIDValid(id string) bool {
-	idparts := strings.Split(id, "--")
-
-	if idparts == nil {
-		return false
-	}
-
-	// First check to see if the object type is valid, if not return false.
-	if valid := isObjectTypeValid(idparts[0]); valid == false {
-		// Short circuit if the object type part is wrong
-		return false
-	}
-
-	// If the type is valid, then check to see if the ID is a UUID, if not return
-	// false.
-	valid := IsUUIDValid(idparts[1])
+	return ValidID(id)
+}
 
-	return valid
+// isRefValid - This function will take in a reference to another object and
+// check to see if it is a valid STIX identifier.
+func isRefValid(id string) bool {
+	return ValidID(id)
 }
 
 // IsCreatedByIDValid - This function will take in an ID and check to see
 // if it is a valid identifier per the specification for an identity object.
 func isCreatedByIDValid(id string) bool {
-	idparts := strings.Split(id, "--")
-
-	if idparts == nil {
-		return false
-	}
-
-	// First check to see if the object type is valid, if not return false.
-	if idparts[0] != "identity" {
-		// Short circuit if the object type part is wrong
-		return false
-	}
-
-	// If the type is valid, then check to see if the ID is a UUID, if not return
-	// false.
-	valid := IsUUIDValid(idparts[1])
-
-	return valid
+	stixType, _, err := ParseID(id)
+	return err == nil && stixType == "identity"
 }
 
 // ----------------------------------------------------------------------
@@ -253,6 +229,31 @@ func (o *CommonObjectProperties) checkCreatedByRefWithExclusions(r *results, exc
 	}
 }
 
+func (o *CommonObjectProperties) checkLang(r *results) {
+	// lang is optional, so only validate if it's present
+	if o.Lang != "" {
+		if valid := IsLangValid(o.Lang); valid == false {
+			logProblem(r, "-- the lang property does not contain a valid RFC 5646 language tag")
+		} else {
+			str := fmt.Sprintf("++ the lang property contains a valid language tag value of \"%s\"", o.Lang)
+			logValid(r, str)
+		}
+	}
+}
+
+func (o *CommonObjectProperties) checkExtensions(r *results) {
+	// extensions are optional, so only validate the names that are present
+	for k := range o.Extensions {
+		if valid := IsExtensionNameValid(k); valid == false {
+			str := fmt.Sprintf("-- the extensions property contains an invalid extension name of \"%s\"", k)
+			logProblem(r, str)
+		} else {
+			str := fmt.Sprintf("++ the extensions property contains a valid extension name of \"%s\"", k)
+			logValid(r, str)
+		}
+	}
+}
+
 func (o *CommonObjectProperties) checkCreated(r *results) {
 	o.checkCreatedWithExclusions(r, nil)
 }
@@ -23,6 +23,7 @@ type Vulnerability struct {
 	objects.CommonObjectProperties
 	objects.NameProperty
 	objects.DescriptionProperty
+	objects.DescriptionsProperty
 }
 
 /*
@@ -31,7 +32,7 @@ are unique to this object. This is used by the custom UnmarshalJSON for this
 object. It is defined here in this file to make it easy to keep in sync.
 */
 func (o *Vulnerability) GetPropertyList() []string {
-	return []string{"name", "description"}
+	return []string{"name", "description", "x_descriptions"}
 }
 
 // ----------------------------------------------------------------------
@@ -48,3 +49,12 @@ func New() *Vulnerability {
 	obj.InitSDO("vulnerability")
 	return &obj
 }
+
+/*
+Clone - This method will return a deep copy of the object. None of the slices
+or maps are shared with the original, so the copy can be changed without
+changing the original.
+*/
+func (o *Vulnerability) Clone() *Vulnerability {
+	return objects.DeepCopy(o).(*Vulnerability)
+}
@@ -0,0 +1,539 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/freetaxii/libstix2/defs"
+	"github.com/freetaxii/libstix2/objects/bundle"
+	"github.com/freetaxii/libstix2/objects/taxii/apiroot"
+	"github.com/freetaxii/libstix2/objects/taxii/collections"
+	"github.com/freetaxii/libstix2/objects/taxii/envelope"
+	"github.com/freetaxii/libstix2/objects/taxii/status"
+	"github.com/freetaxii/libstix2/objects/taxii/taxiierror"
+)
+
+// ----------------------------------------------------------------------
+// Define Types
+// ----------------------------------------------------------------------
+
+/*
+Client - This type implements a TAXII 2.1 client that talks to a single API
+Root on a remote TAXII server.
+
+baseURL          = The full URL of the API Root, for example https://example.com/api1/
+httpClient       = The HTTP client that is used to send all requests
+username         = The username for HTTP basic authentication
+password         = The password for HTTP basic authentication
+token            = The token for HTTP bearer authentication
+pollInterval     = How long to wait between requests for a pending status
+pollTimeout      = How long to keep polling a pending status before giving up
+maxContentLength = The max_content_length of the API Root, 0 until it is read
+maxRetries       = How many times to retry a request that was throttled
+baseDelay        = How long to wait before the first retry
+maxDelay         = The longest time to wait between retries
+limiter          = The rate limiter for outbound requests, nil for no limit
+*/
+type Client struct {
+	baseURL          string
+	httpClient       *http.Client
+	username         string
+	password         string
+	token            string
+	pollInterval     time.Duration
+	pollTimeout      time.Duration
+	maxContentLength int
+	maxRetries       int
+	baseDelay        time.Duration
+	maxDelay         time.Duration
+	limiter          *rateLimiter
+}
+
+/*
+Option - This type defines a function that can be passed to NewClient() to
+change how the client is configured.
+*/
+type Option func(*Client) error
+
+/*
+Filters - This type holds the URL parameters that can be used to filter the
+objects that are returned from a collection. Empty values are not sent.
+
+AddedAfter   = Only return objects added after this timestamp
+Limit        = The number of objects to ask the server for in each page
+Types        = Only return objects of these STIX types
+IDs          = Only return objects with these STIX IDs
+Versions     = Only return these versions, like "last", "first", "all" or a timestamp
+SpecVersions = Only return objects of these STIX specification versions
+Next         = The next value from a previous page, to resume pagination
+*/
+type Filters struct {
+	AddedAfter   string
+	Limit        int
+	Types        []string
+	IDs          []string
+	Versions     []string
+	SpecVersions []string
+	Next         string
+}
+
+/*
+Error - This type is returned when the TAXII server responds with an HTTP
+error status. If the server sent a TAXII error message in the body it is
+decoded in to TAXIIError.
+*/
+type Error struct {
+	StatusCode int
+	TAXIIError *taxiierror.TAXIIError
+}
+
+// ----------------------------------------------------------------------
+// Initialization Functions
+// ----------------------------------------------------------------------
+
+/*
+NewClient - This function will create a new TAXII client for the API Root
+found at baseURL and return it as a pointer. It will return an error if the
+URL can not be parsed or if any of the options fail.
+*/
+func NewClient(baseURL string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("the base url must use http or https, got %q", baseURL)
+	}
+
+	if !strings.HasSuffix(baseURL, "/") {
+		baseURL += "/"
+	}
+
+	c := &Client{
+		baseURL:      baseURL,
+		httpClient:   http.DefaultClient,
+		pollInterval: 2 * time.Second,
+		pollTimeout:  5 * time.Minute,
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+/*
+WithBasicAuth - This function will return an option that configures the
+client to use HTTP basic authentication.
+*/
+func WithBasicAuth(username, password string) Option {
+	return func(c *Client) error {
+		if username == "" {
+			return errors.New("the username for basic authentication can not be empty")
+		}
+		c.username = username
+		c.password = password
+		return nil
+	}
+}
+
+/*
+WithBearerToken - This function will return an option that configures the
+client to use HTTP bearer token authentication.
+*/
+func WithBearerToken(token string) Option {
+	return func(c *Client) error {
+		if token == "" {
+			return errors.New("the bearer token can not be empty")
+		}
+		c.token = token
+		return nil
+	}
+}
+
+/*
+WithHTTPClient - This function will return an option that replaces the HTTP
+client that is used to send requests, for example to set timeouts or TLS
+settings.
+*/
+func WithHTTPClient(h *http.Client) Option {
+	return func(c *Client) error {
+		if h == nil {
+			return errors.New("the http client can not be nil")
+		}
+		c.httpClient = h
+		return nil
+	}
+}
+
+/*
+WithStatusPolling - This function will return an option that sets how often
+AddObjects() checks a pending status and how long it will wait for the server
+to finish before returning the status as it is.
+*/
+func WithStatusPolling(interval, timeout time.Duration) Option {
+	return func(c *Client) error {
+		if interval <= 0 || timeout < 0 {
+			return errors.New("the status poll interval must be greater than zero and the timeout can not be negative")
+		}
+		c.pollInterval = interval
+		c.pollTimeout = timeout
+		return nil
+	}
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - Client
+// ----------------------------------------------------------------------
+
+/*
+GetAPIRoot - This method will get the API Root resource and remember its
+max_content_length for use by AddObjects().
+*/
+func (c *Client) GetAPIRoot() (*apiroot.APIRoot, error) {
+	data, _, err := c.get("", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := apiroot.Decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	c.maxContentLength = obj.GetMaxContentLength()
+	return obj, nil
+}
+
+/*
+GetCollections - This method will get the list of collections that are found
+under the API Root.
+*/
+func (c *Client) GetCollections() (*collections.Collections, error) {
+	data, _, err := c.get("collections/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj collections.Collections
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+/*
+GetObjects - This method will get the objects from the collection that match
+the filters and return them in a bundle. If the server splits the results in
+to pages, this method will keep following the next value in the envelope until
+the server reports that there are no more objects.
+*/
+func (c *Client) GetObjects(collectionID string, filters Filters) (*bundle.Bundle, error) {
+	it, err := c.GetObjectsIterator(collectionID, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	b := bundle.New()
+	for {
+		page, ok, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		b.Objects = append(b.Objects, page.Objects...)
+	}
+
+	return b, nil
+}
+
+/*
+AddObjects - This method will add the objects in the bundle to the collection.
+If the bundle is larger than the max_content_length of the API Root, it is
+split with bundle.SplitBySize() and sent in more than one request. When the
+server has not finished processing a request, the status resource is polled
+until it is complete or the poll timeout is reached. The returned status combines the results of all
+of the requests and carries the id of the first one. It will return an error,
+without making a request, if the bundle is nil or does not have any objects.
+*/
+func (c *Client) AddObjects(collectionID string, b *bundle.Bundle) (*status.Status, error) {
+	if collectionID == "" {
+		return nil, errors.New("the collection id can not be empty")
+	}
+
+	if b == nil {
+		return nil, errors.New("the bundle can not be nil")
+	}
+
+	if len(b.Objects) == 0 {
+		return nil, errors.New("the bundle does not have any objects to add")
+	}
+
+	if c.maxContentLength == 0 {
+		if _, err := c.GetAPIRoot(); err != nil {
+			return nil, err
+		}
+	}
+
+	// An envelope is smaller than a bundle with the same objects, so every part
+	// that SplitBySize returns also fits once it is sent as an envelope.
+	parts := []*bundle.Bundle{b}
+	if c.maxContentLength > 0 {
+		var err error
+		if parts, err = b.SplitBySize(c.maxContentLength); err != nil {
+			return nil, err
+		}
+	}
+
+	path := "collections/" + url.PathEscape(collectionID) + "/objects/"
+	var result *status.Status
+
+	for _, part := range parts {
+		env := envelope.New()
+		for _, v := range part.Objects {
+			env.AddObject(v)
+		}
+
+		body, err := json.Marshal(env)
+		if err != nil {
+			return result, err
+		}
+
+		req, err := http.NewRequest(http.MethodPost, c.baseURL+path, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Accept", defs.MEDIA_TYPE_TAXII21)
+		req.Header.Set("Content-Type", defs.MEDIA_TYPE_TAXII21)
+
+		data, _, err := c.do(req)
+		if err != nil {
+			return result, err
+		}
+
+		var s status.Status
+		if err := json.Unmarshal(data, &s); err != nil {
+			return result, err
+		}
+
+		if err := c.waitForStatus(&s); err != nil {
+			return result, err
+		}
+
+		result = mergeStatus(result, &s)
+	}
+
+	return result, nil
+}
+
+/*
+GetStatus - This method will get the status resource with the id given.
+*/
+func (c *Client) GetStatus(id string) (*status.Status, error) {
+	data, _, err := c.get("status/"+url.PathEscape(id)+"/", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj status.Status
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	return &obj, nil
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - Error
+// ----------------------------------------------------------------------
+
+/*
+Error - This method will return the error as a string.
+*/
+func (e *Error) Error() string {
+	if e.TAXIIError != nil && e.TAXIIError.Title != "" {
+		return fmt.Sprintf("taxii server returned %d: %s", e.StatusCode, e.TAXIIError.Title)
+	}
+	return fmt.Sprintf("taxii server returned %d", e.StatusCode)
+}
+
+// ----------------------------------------------------------------------
+// Private Methods
+// ----------------------------------------------------------------------
+
+/*
+values - This method will convert the filters to URL parameters.
+*/
+func (f Filters) values() url.Values {
+	v := url.Values{}
+
+	if f.AddedAfter != "" {
+		v.Set("added_after", f.AddedAfter)
+	}
+	if f.Limit > 0 {
+		v.Set("limit", strconv.Itoa(f.Limit))
+	}
+	if len(f.Types) > 0 {
+		v.Set("match[type]", strings.Join(f.Types, ","))
+	}
+	if len(f.IDs) > 0 {
+		v.Set("match[id]", strings.Join(f.IDs, ","))
+	}
+	if len(f.Versions) > 0 {
+		v.Set("match[version]", strings.Join(f.Versions, ","))
+	}
+	if len(f.SpecVersions) > 0 {
+		v.Set("match[spec_version]", strings.Join(f.SpecVersions, ","))
+	}
+	if f.Next != "" {
+		v.Set("next", f.Next)
+	}
+
+	return v
+}
+
+/*
+waitForStatus - This method will poll the status resource until the server
+reports that it is complete or the poll timeout is reached. The status is
+updated in place.
+*/
+func (c *Client) waitForStatus(s *status.Status) error {
+	deadline := time.Now().Add(c.pollTimeout)
+
+	for s.Status == "pending" && s.ID != "" && time.Now().Before(deadline) {
+		time.Sleep(c.pollInterval)
+
+		ns, err := c.GetStatus(s.ID)
+		if err != nil {
+			return err
+		}
+		*s = *ns
+	}
+
+	return nil
+}
+
+/*
+mergeStatus - This function will add the counts and details of s to the
+combined status and return it. If the combined status is nil, s is returned.
+*/
+func mergeStatus(combined, s *status.Status) *status.Status {
+	if combined == nil {
+		return s
+	}
+
+	if s.Status == "pending" {
+		combined.Status = s.Status
+	}
+	combined.TotalCount += s.TotalCount
+	combined.SuccessCount += s.SuccessCount
+	combined.Successes = append(combined.Successes, s.Successes...)
+	combined.FailureCount += s.FailureCount
+	combined.Failures = append(combined.Failures, s.Failures...)
+	combined.PendingCount += s.PendingCount
+	combined.Pendings = append(combined.Pendings, s.Pendings...)
+
+	return combined
+}
+
+/*
+get - This method will send a GET request for the path, relative to the API
+Root, and return the body and headers of the response.
+*/
+func (c *Client) get(path string, params url.Values) ([]byte, http.Header, error) {
+	u := c.baseURL + path
+	if len(params) > 0 {
+		u += "?" + params.Encode()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", defs.MEDIA_TYPE_TAXII21)
+
+	return c.do(req)
+}
+
+/*
+do - This method will add the authentication headers to the request, send it,
+and read the response. Throttled requests are retried as configured by WithRetry()
+and every attempt waits on the rate limiter. HTTP error statuses are returned
+as an *Error.
+*/
+func (c *Client) do(req *http.Request) ([]byte, http.Header, error) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	} else if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+
+	var resp *http.Response
+	for attempt := 0; ; attempt++ {
+		if c.limiter != nil {
+			c.limiter.wait()
+		}
+
+		var err error
+		resp, err = c.httpClient.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if !isRetryable(resp.StatusCode) || attempt >= c.maxRetries {
+			break
+		}
+
+		// The body has to be recreated before the request can be sent again
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				resp.Body.Close()
+				return nil, nil, err
+			}
+			req.Body = body
+		}
+
+		d := c.retryDelay(attempt, resp)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		time.Sleep(d)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &Error{StatusCode: resp.StatusCode}
+		var te taxiierror.TAXIIError
+		if json.Unmarshal(data, &te) == nil {
+			e.TAXIIError = &te
+		}
+		return nil, nil, e
+	}
+
+	return data, resp.Header, nil
+}
@@ -0,0 +1,226 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/freetaxii/libstix2/objects/bundle"
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+const testIndicator = `{"type":"indicator","spec_version":"2.1","id":"indicator--%s","created":"2016-04-06T20:03:48.000Z","modified":"2016-04-06T20:03:48.000Z","pattern":"[url:value = 'http://x4z9arb.cn/4712/']","pattern_type":"stix","valid_from":"2016-01-01T00:00:00Z"}`
+
+/*
+TestGetObjectsPagination - Make sure GetObjects follows the next value until
+the server reports there are no more objects, and sends the filters and the
+bearer token.
+*/
+func TestGetObjectsPagination(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api1/collections/c1/objects/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.URL.Query().Get("match[type]") != "indicator" {
+			t.Errorf("Fail match[type] was not sent: %s", r.URL.RawQuery)
+		}
+
+		switch r.URL.Query().Get("next") {
+		case "":
+			fmt.Fprintf(w, `{"more":true,"next":"p2","objects":[`+testIndicator+`]}`, "8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+		case "p2":
+			fmt.Fprintf(w, `{"more":false,"objects":[`+testIndicator+`]}`, "9e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+		}
+	}))
+	defer ts.Close()
+
+	c, err := NewClient(ts.URL+"/api1", WithBearerToken("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := c.GetObjects("c1", Filters{Types: []string{"indicator"}})
+	if err != nil {
+		t.Fatalf("Fail GetObjects returned an error: %v", err)
+	}
+
+	if len(b.Objects) != 2 {
+		t.Errorf("Fail expected 2 objects across both pages, got %d", len(b.Objects))
+	}
+}
+
+/*
+TestGetObjectsLoop - Make sure a server that keeps returning the same next
+value does not cause an endless loop.
+*/
+func TestGetObjectsLoop(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"more":true,"next":"again"}`)
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL)
+	if _, err := c.GetObjects("c1", Filters{}); err == nil {
+		t.Error("Fail GetObjects should stop when the same page is returned twice")
+	}
+}
+
+/*
+TestGetCollectionsError - Make sure a TAXII error message is returned as an
+*Error with the basic auth credentials sent.
+*/
+func TestGetCollectionsError(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); ok && u == "user" && p == "pass" {
+			fmt.Fprint(w, `{"collections":[{"id":"c1","title":"High Value","can_read":true,"can_write":false}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"title":"Not authorized"}`)
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL)
+	_, err := c.GetCollections()
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusUnauthorized || e.TAXIIError.Title != "Not authorized" {
+		t.Errorf("Fail expected a 401 TAXII error, got %v", err)
+	}
+
+	c, _ = NewClient(ts.URL, WithBasicAuth("user", "pass"))
+	cols, err := c.GetCollections()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cols.Collections) != 1 || cols.Collections[0].ID != "c1" {
+		t.Error("Fail collections were not decoded")
+	}
+}
+
+/*
+TestAddObjects - Make sure AddObjects splits a bundle that is larger than the
+max_content_length of the API Root and polls a pending status until it is
+complete.
+*/
+func TestAddObjects(t *testing.T) {
+	posts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/" && r.Method == http.MethodGet:
+			fmt.Fprint(w, `{"title":"API Root","versions":["application/taxii+json;version=2.1"],"max_content_length":400}`)
+		case r.URL.Path == "/collections/c1/objects/" && r.Method == http.MethodPost:
+			if r.Header.Get("Content-Type") != "application/taxii+json;version=2.1" {
+				t.Errorf("Fail wrong content type: %s", r.Header.Get("Content-Type"))
+			}
+			if r.ContentLength > 400 {
+				t.Errorf("Fail request of %d bytes is larger than max_content_length", r.ContentLength)
+			}
+			posts++
+			fmt.Fprintf(w, `{"id":"s%d","status":"pending","total_count":1,"pending_count":1}`, posts)
+		case r.URL.Path == "/status/s1/" || r.URL.Path == "/status/s2/":
+			fmt.Fprint(w, `{"id":"s","status":"complete","total_count":1,"success_count":1}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL, WithStatusPolling(time.Millisecond, time.Second))
+
+	b := bundle.New()
+	for i := 0; i < 2; i++ {
+		o := indicator.New()
+		o.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+		b.AddObject(o)
+	}
+
+	s, err := c.AddObjects("c1", b)
+	if err != nil {
+		t.Fatalf("Fail AddObjects returned an error: %v", err)
+	}
+
+	if posts != 2 {
+		t.Errorf("Fail expected the bundle to be split in to 2 requests, got %d", posts)
+	}
+
+	if s.Status != "complete" || s.SuccessCount != 2 || s.TotalCount != 2 {
+		t.Errorf("Fail combined status is wrong: %+v", s)
+	}
+}
+
+/*
+TestAddObjectsEmpty - Make sure a nil or empty bundle is an error and that no
+request is made for it.
+*/
+func TestAddObjectsEmpty(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL)
+
+	if s, err := c.AddObjects("c1", nil); err == nil || s != nil {
+		t.Error("Fail AddObjects should reject a nil bundle")
+	}
+
+	if s, err := c.AddObjects("c1", bundle.New()); err == nil || s != nil {
+		t.Error("Fail AddObjects should reject an empty bundle")
+	}
+
+	if requests != 0 {
+		t.Errorf("Fail expected no requests, got %d", requests)
+	}
+}
+
+/*
+TestAddObjectsTooLarge - Make sure an object that does not fit in the
+max_content_length of the API Root on its own is an error and is not posted.
+*/
+func TestAddObjectsTooLarge(t *testing.T) {
+	posts := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{"title":"API Root","versions":["application/taxii+json;version=2.1"],"max_content_length":100}`)
+		case http.MethodPost:
+			posts++
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL)
+
+	b := bundle.New()
+	o := indicator.New()
+	o.SetPattern("[url:value = 'http://x4z9arb.cn/4712/']")
+	b.AddObject(o)
+
+	if _, err := c.AddObjects("c1", b); err == nil {
+		t.Error("Fail AddObjects should reject an object larger than max_content_length")
+	}
+
+	if posts != 0 {
+		t.Errorf("Fail expected no posts, got %d", posts)
+	}
+}
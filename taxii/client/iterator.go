@@ -0,0 +1,133 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/freetaxii/libstix2/objects/bundle"
+	"github.com/freetaxii/libstix2/objects/taxii/envelope"
+)
+
+// ----------------------------------------------------------------------
+// Define Types
+// ----------------------------------------------------------------------
+
+/*
+ObjectsIterator - This type walks the pages of objects in a collection one
+request at a time, so that a large collection does not need to be held in
+memory. It is created with GetObjectsIterator().
+
+client  = The client that is used to make the requests
+path    = The path of the objects endpoint for the collection
+filters = The filters for the next request, updated as pages are read
+seen    = The next values that have already been requested, to catch loops
+done    = Set once the server reports that there are no more objects
+err     = An error found after a page was read, returned by the next call
+*/
+type ObjectsIterator struct {
+	client  *Client
+	path    string
+	filters Filters
+	seen    map[string]bool
+	done    bool
+	err     error
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - Client
+// ----------------------------------------------------------------------
+
+/*
+GetObjectsIterator - This method will return an iterator over the objects in
+the collection that match the filters. No request is made until Next() is
+called.
+*/
+func (c *Client) GetObjectsIterator(collectionID string, filters Filters) (*ObjectsIterator, error) {
+	if collectionID == "" {
+		return nil, errors.New("the collection id can not be empty")
+	}
+
+	it := &ObjectsIterator{
+		client:  c,
+		path:    "collections/" + url.PathEscape(collectionID) + "/objects/",
+		filters: filters,
+		seen:    make(map[string]bool),
+	}
+	return it, nil
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - ObjectsIterator
+// ----------------------------------------------------------------------
+
+/*
+Next - This method will request the next page of objects and return it as a
+bundle. The boolean is false, with a nil bundle, once all of the pages have
+been read. TAXII 2.1 servers page with the next property of the envelope, for
+servers that do not send it the X-TAXII-Date-Added-Last header is used as the
+added_after of the next request. If a page was read but the next one can not be
+requested, because the server did not say how to get it or sent the same page
+again, that page is still returned and the error is returned by the next call.
+*/
+func (it *ObjectsIterator) Next() (*bundle.Bundle, bool, error) {
+	if it.done {
+		err := it.err
+		it.err = nil
+		return nil, false, err
+	}
+
+	data, header, err := it.client.get(it.path, it.filters.values())
+	if err != nil {
+		it.done = true
+		return nil, false, err
+	}
+
+	var env envelope.EnvelopeRawDecode
+	if err := json.Unmarshal(data, &env); err != nil {
+		it.done = true
+		return nil, false, err
+	}
+
+	b := bundle.New()
+	for _, raw := range env.Objects {
+		obj, err := bundle.DecodeObject(raw)
+		if err != nil {
+			it.done = true
+			return nil, false, err
+		}
+		b.AddObject(obj)
+	}
+
+	if !env.More {
+		it.done = true
+		return b, true, nil
+	}
+
+	next := env.Next
+	if next != "" {
+		it.filters.Next = next
+	} else if last := header.Get("X-TAXII-Date-Added-Last"); last != "" {
+		next = "added_after=" + last
+		it.filters.AddedAfter = last
+	} else {
+		it.done = true
+		it.err = errors.New("the server reported more objects but did not say how to get them")
+		return b, true, nil
+	}
+
+	if it.seen[next] {
+		it.done = true
+		it.err = fmt.Errorf("the server returned the same page twice (%s)", next)
+		return b, true, nil
+	}
+	it.seen[next] = true
+
+	return b, true, nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestObjectsIterator - Make sure the iterator only requests a page when Next is
+called, falls back to the X-TAXII-Date-Added-Last header, and stops after the
+last page.
+*/
+func TestObjectsIterator(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Query().Get("added_after") {
+		case "":
+			w.Header().Set("X-TAXII-Date-Added-Last", "2021-01-01T00:00:00.000Z")
+			fmt.Fprintf(w, `{"more":true,"objects":[`+testIndicator+`]}`, "8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+		case "2021-01-01T00:00:00.000Z":
+			fmt.Fprintf(w, `{"more":false,"objects":[`+testIndicator+`]}`, "9e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+		}
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL)
+	it, err := c.GetObjectsIterator("c1", Filters{})
+	if err != nil {
+		t.Fatalf("Fail GetObjectsIterator returned an error: %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("Fail no request should be made before Next is called, got %d", requests)
+	}
+
+	pages := 0
+	for {
+		b, ok, err := it.Next()
+		if err != nil {
+			t.Fatalf("Fail Next returned an error: %v", err)
+		}
+		if !ok {
+			break
+		}
+		pages++
+		if requests != pages {
+			t.Errorf("Fail expected %d requests, got %d", pages, requests)
+		}
+		if len(b.Objects) != 1 {
+			t.Errorf("Fail expected 1 object on page %d, got %d", pages, len(b.Objects))
+		}
+	}
+
+	if pages != 2 {
+		t.Errorf("Fail expected 2 pages, got %d", pages)
+	}
+
+	if _, err := c.GetObjectsIterator("", Filters{}); err == nil {
+		t.Error("Fail GetObjectsIterator should require a collection id")
+	}
+}
+
+/*
+TestObjectsIteratorKeepsPage - Make sure a page that was read is returned
+when the next page can not be requested, and the error is returned by the
+following call.
+*/
+func TestObjectsIteratorKeepsPage(t *testing.T) {
+	tests := map[string]http.HandlerFunc{
+		"no next": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"more":true,"objects":[`+testIndicator+`]}`, "8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+		},
+		"same page": func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"more":true,"next":"abc","objects":[`+testIndicator+`]}`, "8e2e2d2b-17d4-4cbf-938f-98ee46b3cd3f")
+		},
+	}
+
+	for name, handler := range tests {
+		ts := httptest.NewServer(handler)
+		c, _ := NewClient(ts.URL)
+		it, _ := c.GetObjectsIterator("c1", Filters{})
+
+		pages := 0
+		var last error
+		for i := 0; i < 5; i++ {
+			b, ok, err := it.Next()
+			if !ok {
+				if b != nil {
+					t.Errorf("Fail %s expected a nil bundle when done", name)
+				}
+				last = err
+				break
+			}
+			if err != nil || len(b.Objects) != 1 {
+				t.Errorf("Fail %s expected a page with 1 object and no error, got %v", name, err)
+			}
+			pages++
+		}
+
+		want := 1
+		if name == "same page" {
+			want = 2
+		}
+		if pages != want {
+			t.Errorf("Fail %s expected %d pages, got %d", name, want, pages)
+		}
+		if last == nil {
+			t.Errorf("Fail %s expected the error on the call after the last page", name)
+		}
+
+		if _, ok, err := it.Next(); ok || err != nil {
+			t.Errorf("Fail %s expected the iterator to stay done without an error, got %v", name, err)
+		}
+		ts.Close()
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package client
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ----------------------------------------------------------------------
+// Define Types
+// ----------------------------------------------------------------------
+
+/*
+rateLimiter - This type implements a token bucket. Tokens are added at rate
+per second up to burst, and each outbound request takes one token, waiting for
+one to become available if the bucket is empty.
+*/
+type rateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - Options
+// ----------------------------------------------------------------------
+
+/*
+WithRetry - This function will return an option that makes the client retry
+requests that fail with 429 Too Many Requests or 503 Service Unavailable. The
+client waits baseDelay before the first retry and doubles the wait for each
+retry after that, up to maxDelay. If the server sends a Retry-After header, its
+value is used instead. The error is only returned once maxRetries retries have
+failed.
+*/
+func WithRetry(maxRetries int, baseDelay, maxDelay time.Duration) Option {
+	return func(c *Client) error {
+		if maxRetries < 0 || baseDelay <= 0 || maxDelay < baseDelay {
+			return errors.New("the retry count can not be negative and the delays must be greater than zero with maxDelay at least baseDelay")
+		}
+		c.maxRetries = maxRetries
+		c.baseDelay = baseDelay
+		c.maxDelay = maxDelay
+		return nil
+	}
+}
+
+/*
+WithRateLimit - This function will return an option that limits the client to
+rate requests per second, allowing short bursts of up to burst requests.
+*/
+func WithRateLimit(rate float64, burst int) Option {
+	return func(c *Client) error {
+		if rate <= 0 || burst < 1 {
+			return errors.New("the rate must be greater than zero and the burst must be at least 1")
+		}
+		c.limiter = &rateLimiter{
+			rate:   rate,
+			burst:  float64(burst),
+			tokens: float64(burst),
+			last:   time.Now(),
+		}
+		return nil
+	}
+}
+
+// ----------------------------------------------------------------------
+// Private Methods
+// ----------------------------------------------------------------------
+
+/*
+wait - This method will block until a token is available and then take it.
+*/
+func (r *rateLimiter) wait() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.tokens += now.Sub(r.last).Seconds() * r.rate
+	if r.tokens > r.burst {
+		r.tokens = r.burst
+	}
+	r.last = now
+
+	if r.tokens < 1 {
+		d := time.Duration((1 - r.tokens) / r.rate * float64(time.Second))
+		time.Sleep(d)
+		r.last = r.last.Add(d)
+		r.tokens = 1
+	}
+
+	r.tokens--
+}
+
+/*
+retryDelay - This method will return how long to wait before the retry with
+the attempt number given, which starts at 0. A Retry-After header in the
+response takes priority over the exponential backoff.
+*/
+func (c *Client) retryDelay(attempt int, resp *http.Response) time.Duration {
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		if secs, err := strconv.Atoi(ra); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(ra); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d
+			}
+			return 0
+		}
+	}
+
+	d := c.baseDelay << uint(attempt)
+	if d > c.maxDelay || d <= 0 {
+		d = c.maxDelay
+	}
+	return d
+}
+
+/*
+isRetryable - This function will return true if the HTTP status code means the
+request can be tried again later.
+*/
+func isRetryable(code int) bool {
+	return code == http.StatusTooManyRequests || code == http.StatusServiceUnavailable
+}
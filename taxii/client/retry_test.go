@@ -0,0 +1,91 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestRetry - Make sure throttled requests are retried and only surface as an
+error once the retries are used up.
+*/
+func TestRetry(t *testing.T) {
+	calls := 0
+	failures := 2
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= failures {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"collections":[]}`)
+	}))
+	defer ts.Close()
+
+	c, _ := NewClient(ts.URL, WithRetry(2, time.Millisecond, 10*time.Millisecond))
+	if _, err := c.GetCollections(); err != nil {
+		t.Errorf("Fail request should succeed after 2 retries: %v", err)
+	}
+
+	calls = 0
+	failures = 5
+	_, err := c.GetCollections()
+	var e *Error
+	if !errors.As(err, &e) || e.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("Fail expected a 429 error once retries are exhausted, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Fail expected 3 attempts, got %d", calls)
+	}
+}
+
+/*
+TestRetryDelay - Make sure the backoff doubles and is capped, and that the
+Retry-After header wins.
+*/
+func TestRetryDelay(t *testing.T) {
+	c, _ := NewClient("http://localhost/", WithRetry(5, 10*time.Millisecond, 30*time.Millisecond))
+
+	resp := &http.Response{Header: http.Header{}}
+	for i, want := range []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond} {
+		if got := c.retryDelay(i, resp); got != want {
+			t.Errorf("Fail attempt %d: expected %v, got %v", i, want, got)
+		}
+	}
+
+	resp.Header.Set("Retry-After", "3")
+	if got := c.retryDelay(0, resp); got != 3*time.Second {
+		t.Errorf("Fail Retry-After was not honored, got %v", got)
+	}
+}
+
+/*
+TestRateLimit - Make sure requests beyond the burst are delayed.
+*/
+func TestRateLimit(t *testing.T) {
+	c, _ := NewClient("http://localhost/", WithRateLimit(100, 2))
+
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		c.limiter.wait()
+	}
+
+	// Two requests are in the burst, the other two need 10ms each
+	if d := time.Since(start); d < 15*time.Millisecond {
+		t.Errorf("Fail rate limiter did not delay requests, took %v", d)
+	}
+}
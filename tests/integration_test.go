@@ -131,7 +131,7 @@ func TestSCOPropertySetters(t *testing.T) {
 	t.Run("File Properties", func(t *testing.T) {
 		obj := file.New()
 		obj.SetName("malware.exe")
-		obj.AddHash("SHA-256", "abc123")
+		obj.AddHash("SHA-256", "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
 		obj.SetSize(1024)
 		obj.SetMimeType("application/octet-stream")
 
@@ -155,7 +155,7 @@ func TestSCOPropertySetters(t *testing.T) {
 		obj := artifact.New()
 		obj.SetPayloadBin("VGVzdA==")
 		obj.SetMimeType("text/plain")
-		obj.AddHash("MD5", "test-hash")
+		obj.AddHash("MD5", "d41d8cd98f00b204e9800998ecf8427e")
 
 		if obj.PayloadBin != "VGVzdA==" {
 			t.Error("PayloadBin not set correctly")
@@ -0,0 +1,13 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+/*
+Package profile implements required-property profiles. A sharing community
+can require properties that the STIX specification leaves optional, like
+created_by_ref or confidence, by registering a profile and validating objects
+against it with ValidateProfile(). This is layered on top of the Valid()
+methods of each object, which only check the specification itself.
+*/
+package profile
@@ -0,0 +1,196 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/freetaxii/libstix2/objects"
+)
+
+// DefaultProfile - This is the name of the profile that is registered by
+// default. It does not require any properties, so nothing changes unless a
+// caller opts in to a profile of their own.
+const DefaultProfile = "default"
+
+// AllTypes - This can be used as the object type with Require() to require a
+// property on every type of object.
+const AllTypes = "*"
+
+// ----------------------------------------------------------------------
+// Define Types
+// ----------------------------------------------------------------------
+
+/*
+Profile - This type defines a named set of properties that are required for
+each object type, in addition to the ones required by the specification.
+
+Name     = The name the profile is registered under
+Required = The JSON names of the required properties, keyed by object type
+*/
+type Profile struct {
+	Name     string
+	Required map[string][]string
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]*Profile{
+		DefaultProfile: New(DefaultProfile),
+	}
+)
+
+// ----------------------------------------------------------------------
+// Initialization Functions
+// ----------------------------------------------------------------------
+
+/*
+New - This function will create a new empty profile with the name given and
+return it as a pointer.
+*/
+func New(name string) *Profile {
+	var obj Profile
+	obj.Name = name
+	obj.Required = make(map[string][]string)
+	return &obj
+}
+
+// ----------------------------------------------------------------------
+// Public Methods - Profile
+// ----------------------------------------------------------------------
+
+/*
+Require - This method takes in an object type, or AllTypes, and the JSON names
+of the properties that the profile requires on that type of object.
+*/
+func (p *Profile) Require(objectType string, properties ...string) error {
+	if objectType == "" {
+		return errors.New("the object type can not be empty")
+	}
+
+	for _, v := range properties {
+		if v == "" {
+			return errors.New("the property name can not be empty")
+		}
+		p.Required[objectType] = append(p.Required[objectType], v)
+	}
+	return nil
+}
+
+/*
+RequiredFor - This method will return the sorted list of properties that the
+profile requires on the object type given, including the ones required on all
+types.
+*/
+func (p *Profile) RequiredFor(objectType string) []string {
+	found := make(map[string]bool)
+	for _, v := range p.Required[AllTypes] {
+		found[v] = true
+	}
+	for _, v := range p.Required[objectType] {
+		found[v] = true
+	}
+
+	props := make([]string, 0, len(found))
+	for v := range found {
+		props = append(props, v)
+	}
+	sort.Strings(props)
+	return props
+}
+
+// ----------------------------------------------------------------------
+// Public Functions - Registry
+// ----------------------------------------------------------------------
+
+/*
+Register - This function will add the profile to the registry, replacing any
+profile that was registered with the same name.
+*/
+func Register(p *Profile) error {
+	if p == nil || p.Name == "" {
+		return errors.New("the profile must have a name")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[p.Name] = p
+	return nil
+}
+
+/*
+Get - This function will return the profile registered with the name given.
+*/
+func Get(name string) (*Profile, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	p, found := registry[name]
+	return p, found
+}
+
+/*
+ValidateProfile - This function will check that the object has all of the
+properties that the named profile requires for its type. A property that is
+present but empty, like an empty string or list, is treated as missing. It
+will return a boolean and a slice of strings that describe each problem,
+using the same "-- " prefix as the Valid() methods.
+*/
+func ValidateProfile(obj objects.STIXObject, profileName string) (bool, []string) {
+	resultDetails := make([]string, 0)
+
+	p, found := Get(profileName)
+	if !found {
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The profile %q is not registered", profileName))
+		return false, resultDetails
+	}
+
+	objectType := obj.GetCommonProperties().GetObjectType()
+	required := p.RequiredFor(objectType)
+	if len(required) == 0 {
+		return true, resultDetails
+	}
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The object could not be encoded: %v", err))
+		return false, resultDetails
+	}
+
+	var props map[string]json.RawMessage
+	if err := json.Unmarshal(data, &props); err != nil {
+		resultDetails = append(resultDetails, fmt.Sprintf("-- The object could not be decoded: %v", err))
+		return false, resultDetails
+	}
+
+	for _, name := range required {
+		if isEmpty(props[name]) {
+			str := fmt.Sprintf("-- The %s property is required by the %s profile for %s objects but missing", name, p.Name, objectType)
+			resultDetails = append(resultDetails, str)
+		}
+	}
+
+	return len(resultDetails) == 0, resultDetails
+}
+
+// ----------------------------------------------------------------------
+// Private Functions
+// ----------------------------------------------------------------------
+
+/*
+isEmpty - This function will return true if the raw JSON value is missing,
+null, or an empty string, list, or object.
+*/
+func isEmpty(raw json.RawMessage) bool {
+	switch string(raw) {
+	case "", "null", `""`, "[]", "{}":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+// Copyright 2015-2022 Bret Jordan, All rights reserved.
+//
+// Use of this source code is governed by an Apache 2.0 license that can be
+// found in the LICENSE file in the root of the source tree.
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/freetaxii/libstix2/objects/indicator"
+)
+
+// ----------------------------------------------------------------------
+// Tests
+// ----------------------------------------------------------------------
+
+/*
+TestValidateProfile - Make sure the default profile does not require anything
+and a registered profile reports each missing property.
+*/
+func TestValidateProfile(t *testing.T) {
+	i := indicator.New()
+
+	if valid, details := ValidateProfile(i, DefaultProfile); !valid {
+		t.Errorf("Fail the default profile should not require anything: %v", details)
+	}
+
+	p := New("community")
+	p.Require(AllTypes, "created_by_ref")
+	p.Require("indicator", "confidence", "name")
+	if err := Register(p); err != nil {
+		t.Fatalf("Fail Register returned an error: %v", err)
+	}
+
+	i.SetName("Malicious site")
+	valid, details := ValidateProfile(i, "community")
+	if valid || len(details) != 2 {
+		t.Errorf("Fail expected created_by_ref and confidence to be missing, got %v", details)
+	}
+
+	i.SetCreatedByRef("identity--f431f809-377b-45e0-aa1c-6a4751cae5ff")
+	i.SetConfidence(80)
+	if valid, details := ValidateProfile(i, "community"); !valid {
+		t.Errorf("Fail the indicator should meet the profile: %v", details)
+	}
+
+	if valid, _ := ValidateProfile(i, "missing"); valid {
+		t.Error("Fail an unknown profile should not be valid")
+	}
+}